@@ -3,6 +3,7 @@
 package main
 
 import (
+	"context"
 	"crypto/hmac"
 	"crypto/sha1"
 	"encoding/binary"
@@ -49,6 +50,8 @@ type SpotifySecrets []SpotifySecret
 
 var (
 	err404 = fmt.Errorf("no lyrics found (404)")
+
+	spotifyLimiter = newRateLimiter(SPOTIFY_REQUEST_INTERVAL_MS)
 )
 
 func getTokenCacheFile() (string, error) {
@@ -59,34 +62,6 @@ func getTokenCacheFile() (string, error) {
 	return filepath.Join(cacheDir, "spotify_token.json"), nil
 }
 
-func getFetchLogFile() (string, error) {
-	cacheDir, err := getCacheDir()
-	if err != nil {
-		return "", err
-	}
-	return filepath.Join(cacheDir, "fetch.log"), nil
-}
-
-// will be called each time a new fetch is performed
-func appendFetchLog(trackid string, msg string) error {
-	logFile, err := getFetchLogFile()
-	if err != nil {
-		return fmt.Errorf("error getting fetch log file: %w", err)
-	}
-	f, err := os.OpenFile(logFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-	if err != nil {
-		return fmt.Errorf("error opening fetch log file: %w", err)
-	}
-	defer f.Close()
-
-	timestamp := time.Now().Format(time.RFC3339)
-	logEntry := fmt.Sprintf("%s [%s] %s\n", timestamp, trackid, msg)
-	if _, err := f.WriteString(logEntry); err != nil {
-		return fmt.Errorf("error writing to fetch log file: %w", err)
-	}
-	return nil
-}
-
 // return a valid TokenResponse or nil if error occurs or token expires or whatever
 func checkTokenValid() *TokenResponse {
 	tokenFile, err := getTokenCacheFile()
@@ -126,7 +101,7 @@ func writeTokenCache(tokenData *TokenResponse) error {
 	if err != nil {
 		return fmt.Errorf("error marshaling token data: %w", err)
 	}
-	if err := os.WriteFile(tokenFile, content, 0644); err != nil {
+	if err := writeFileAtomic(tokenFile, content, 0644); err != nil {
 		return fmt.Errorf("error writing token cache file: %w", err)
 	}
 	log("Token cache file written successfully")
@@ -313,7 +288,7 @@ func getLatestSecretKeyVersion() (string, int, error) {
 	return transformedStr, versionVal, nil
 }
 
-func getLyrics(trackID string) (*LyricsResponse, error) {
+func getLyrics(ctx context.Context, trackID string) (*LyricsResponse, error) {
 	token, err := getToken()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get token: %w", err)
@@ -322,7 +297,7 @@ func getLyrics(trackID string) (*LyricsResponse, error) {
 	client := &http.Client{Timeout: FETCH_TIMEOUT}
 
 	reqUrl := LYRICS_URL + trackID + "?format=json&market=from_token"
-	req, err := http.NewRequest("GET", reqUrl, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", reqUrl, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -330,6 +305,9 @@ func getLyrics(trackID string) (*LyricsResponse, error) {
 	req.Header.Set("App-platform", "WebPlayer")
 	req.Header.Set("Authorization", "Bearer "+token)
 
+	if err := spotifyLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch lyrics: %w", err)
@@ -340,6 +318,9 @@ func getLyrics(trackID string) (*LyricsResponse, error) {
 		if resp.StatusCode == http.StatusNotFound {
 			return nil, err404
 		}
+		if resp.StatusCode == http.StatusTooManyRequests {
+			spotifyLimiter.Backoff(retryAfter(resp))
+		}
 		return nil, fmt.Errorf("API returned status code: %d", resp.StatusCode)
 	}
 
@@ -356,8 +337,8 @@ func getLyrics(trackID string) (*LyricsResponse, error) {
 	return &lyricsResp, nil
 }
 
-func (data *LyricsData) fetchLyricsSpotify() error {
-	resp, err := getLyrics(data.TrackID)
+func (data *LyricsData) fetchLyricsSpotify(ctx context.Context) error {
+	resp, err := getLyrics(ctx, data.TrackID)
 	if err != nil {
 		if err == err404 {
 			data.Is404 = true
@@ -366,6 +347,7 @@ func (data *LyricsData) fetchLyricsSpotify() error {
 		return err
 	}
 	data.IsLineSynced = resp.Lyrics.SyncType == "LINE_SYNCED"
+	data.Provider = "spotify"
 
 	for _, line := range resp.Lyrics.Lines {
 		ms, err := strconv.Atoi(line.StartTimeMs)
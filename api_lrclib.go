@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -13,18 +14,33 @@ type LrclibLyricsResponse struct {
 	SyncedLyrics string `json:"syncedLyrics"`
 }
 
-func (data *LyricsData) fetchLyricsLrclib() error {
+type LrclibSearchResult struct {
+	TrackName    string  `json:"trackName"`
+	ArtistName   string  `json:"artistName"`
+	AlbumName    string  `json:"albumName"`
+	Duration     float64 `json:"duration"` // in seconds
+	Instrumental bool    `json:"instrumental"`
+	PlainLyrics  string  `json:"plainLyrics"`
+	SyncedLyrics string  `json:"syncedLyrics"`
+}
+
+var lrclibLimiter = newRateLimiter(LRCLIB_REQUEST_INTERVAL_MS)
+
+func (data *LyricsData) fetchLyricsLrclib(ctx context.Context) error {
 	client := &http.Client{Timeout: FETCH_TIMEOUT}
 	reqUrl := LRCLIB_API_URL +
 		"?track_name=" + url.QueryEscape(data.Title) +
 		"&artist_name=" + url.QueryEscape(data.Artist) +
 		"&album_name=" + url.QueryEscape(data.Album) +
 		"&duration=" + strconv.Itoa(data.Length/1000)
-	req, err := http.NewRequest("GET", reqUrl, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", reqUrl, nil)
 	if err != nil {
 		return err
 	}
 	req.Header.Set("User-Agent", USER_AGENT_HONEST)
+	if err := lrclibLimiter.Wait(ctx); err != nil {
+		return err
+	}
 	resp, err := client.Do(req)
 	if err != nil {
 		return err
@@ -35,6 +51,9 @@ func (data *LyricsData) fetchLyricsLrclib() error {
 		if resp.StatusCode == http.StatusNotFound {
 			data.Is404 = true
 		}
+		if resp.StatusCode == http.StatusTooManyRequests {
+			lrclibLimiter.Backoff(retryAfter(resp))
+		}
 		return fmt.Errorf("API returned status code: %d", resp.StatusCode)
 	}
 
@@ -46,10 +65,71 @@ func (data *LyricsData) fetchLyricsLrclib() error {
 	if len(lines) == 0 {
 		return fmt.Errorf("invalid lrclib response format: no lines found")
 	}
+	// replace whatever a previous provider found
+	data.Lyrics = nil
+	data.Is404 = false
 	err = data.lrcDecodeLines(lines)
 	if data == nil || err != nil {
 		return fmt.Errorf("failed to decode lyrics: %w", err)
 	}
+	if len(data.Lyrics) == 0 {
+		// e.g. only plain lyrics, as good as none
+		data.Is404 = true
+		return fmt.Errorf("lrclib has no synced lyrics")
+	}
 	data.IsLineSynced = true
+	data.Provider = "lrclib"
 	return nil
 }
+
+func searchLyricsLrclib(ctx context.Context, query string) ([]*SearchResult, error) {
+	client := &http.Client{Timeout: FETCH_TIMEOUT}
+	req, err := http.NewRequestWithContext(ctx, "GET", LRCLIB_SEARCH_API_URL+"?q="+url.QueryEscape(query), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", USER_AGENT_HONEST)
+	if err := lrclibLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		if resp.StatusCode == http.StatusTooManyRequests {
+			lrclibLimiter.Backoff(retryAfter(resp))
+		}
+		return nil, fmt.Errorf("API returned status code: %d", resp.StatusCode)
+	}
+	var found []LrclibSearchResult
+	if err := json.NewDecoder(resp.Body).Decode(&found); err != nil {
+		return nil, fmt.Errorf("failed to parse lrclib response: %w", err)
+	}
+
+	var ret []*SearchResult
+	for _, f := range found {
+		if f.Instrumental {
+			continue
+		}
+		data := &LyricsData{
+			Artist:   f.ArtistName,
+			Title:    f.TrackName,
+			Album:    f.AlbumName,
+			Length:   int(f.Duration * 1000),
+			Provider: "lrclib",
+		}
+		if f.SyncedLyrics != "" {
+			data.IsLineSynced = true
+			data.lrcDecodeLines(strings.Split(strings.TrimSpace(f.SyncedLyrics), "\n"))
+		} else {
+			for _, line := range strings.Split(strings.TrimSpace(f.PlainLyrics), "\n") {
+				data.Lyrics = append(data.Lyrics, LyricLine{Words: line})
+			}
+		}
+		ret = append(ret, &SearchResult{LyricsData: data})
+	}
+	return ret, nil
+}
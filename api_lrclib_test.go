@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// a reply without synced lyrics is no result
+func TestFetchLyricsLrclibNoSynced(t *testing.T) {
+	for _, body := range []string{
+		`{"plainLyrics":"plain","syncedLyrics":null}`,
+		`{"plainLyrics":"plain","syncedLyrics":""}`,
+	} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(body))
+		}))
+		oldURL := LRCLIB_API_URL
+		LRCLIB_API_URL = server.URL
+		data := &LyricsData{Title: "Song", Artist: "Artist", Length: 180000}
+		err := data.fetchLyricsLrclib(context.Background())
+		LRCLIB_API_URL = oldURL
+		server.Close()
+		if err == nil || !data.Is404 || data.IsLineSynced {
+			t.Errorf("reply %s: got %v with %+v, want an error and Is404", body, err, data)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Spotify web API, only used to resolve track metadata, playlists and
+// albums. The web player token obtained in getToken works for it as well.
+
+type WebTrack struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	DurationMs int    `json:"duration_ms"`
+	Artists    []struct {
+		Name string `json:"name"`
+	} `json:"artists"`
+	Album *struct {
+		Name string `json:"name"`
+	} `json:"album"` // missing in album track listings
+}
+
+type webPlaylistTracks struct {
+	Items []struct {
+		Track *WebTrack `json:"track"` // null for removed tracks
+	} `json:"items"`
+	Next string `json:"next"`
+}
+
+type webAlbumTracks struct {
+	Items []*WebTrack `json:"items"`
+	Next  string      `json:"next"`
+}
+
+type webSearch struct {
+	Tracks struct {
+		Items []*WebTrack `json:"items"`
+	} `json:"tracks"`
+}
+
+type webAlbum struct {
+	Name   string         `json:"name"`
+	Tracks webAlbumTracks `json:"tracks"`
+}
+
+// matches IDs, URIs like spotify:track:<id> and URLs like
+// https://open.spotify.com/intl-de/track/<id>?si=...
+var spotifyRefRe = regexp.MustCompile(`^(?:spotify:([a-z]+):|https?://open\.spotify\.com/(?:intl-[a-z-]+/)?([a-z]+)/)?([0-9A-Za-z]{22})(?:[?#].*)?$`)
+
+// extracts the ID of a Spotify track, album or playlist from an ID, URI or
+// URL. kind is checked if the reference includes it.
+func parseSpotifyRef(ref, kind string) (string, error) {
+	matches := spotifyRefRe.FindStringSubmatch(strings.TrimSpace(ref))
+	if matches == nil {
+		return "", fmt.Errorf("invalid Spotify %s: %s", kind, ref)
+	}
+	if k := matches[1] + matches[2]; k != "" && k != kind {
+		return "", fmt.Errorf("expected a Spotify %s, got a %s: %s", kind, k, ref)
+	}
+	return matches[3], nil
+}
+
+func (t *WebTrack) metadata(album string) *TrackMetadata {
+	artists := make([]string, len(t.Artists))
+	for i, a := range t.Artists {
+		artists[i] = a.Name
+	}
+	if t.Album != nil {
+		album = t.Album.Name
+	}
+	return &TrackMetadata{
+		MprisID: "spotify:track:" + t.ID,
+		Artist:  strings.Join(artists, ", "),
+		Title:   t.Name,
+		Album:   album,
+		Length:  t.DurationMs,
+	}
+}
+
+func webAPIGet(reqUrl string, v any) error {
+	token, err := getToken()
+	if err != nil {
+		return fmt.Errorf("failed to get token: %w", err)
+	}
+	if !strings.HasPrefix(reqUrl, "https://") {
+		reqUrl = SPOTIFY_WEB_API_URL + reqUrl
+	}
+
+	client := &http.Client{Timeout: FETCH_TIMEOUT}
+	req, err := http.NewRequest("GET", reqUrl, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", USER_AGENT)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	if err := spotifyLimiter.Wait(context.Background()); err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("web API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		if resp.StatusCode == http.StatusTooManyRequests {
+			spotifyLimiter.Backoff(retryAfter(resp))
+		}
+		return fmt.Errorf("web API returned status code: %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read web API response: %w", err)
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("failed to parse web API response: %w", err)
+	}
+	return nil
+}
+
+func getSpotifyTrack(id string) (*TrackMetadata, error) {
+	var track WebTrack
+	if err := webAPIGet("tracks/"+url.PathEscape(id), &track); err != nil {
+		return nil, err
+	}
+	return track.metadata(""), nil
+}
+
+func getSpotifyPlaylistTracks(id string) ([]*TrackMetadata, error) {
+	var ret []*TrackMetadata
+	next := "playlists/" + url.PathEscape(id) + "/tracks?limit=100"
+	for next != "" {
+		var page webPlaylistTracks
+		if err := webAPIGet(next, &page); err != nil {
+			return nil, err
+		}
+		for _, item := range page.Items {
+			// local files and episodes have no track ID
+			if item.Track != nil && item.Track.ID != "" {
+				ret = append(ret, item.Track.metadata(""))
+			}
+		}
+		next = page.Next
+	}
+	return ret, nil
+}
+
+func getSpotifyAlbumTracks(id string) ([]*TrackMetadata, error) {
+	var album webAlbum
+	if err := webAPIGet("albums/"+url.PathEscape(id), &album); err != nil {
+		return nil, err
+	}
+	var ret []*TrackMetadata
+	page := album.Tracks
+	for {
+		for _, track := range page.Items {
+			ret = append(ret, track.metadata(album.Name))
+		}
+		if page.Next == "" {
+			break
+		}
+		next := page.Next
+		page = webAlbumTracks{}
+		if err := webAPIGet(next, &page); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+// tracks found by the Spotify search, with their lyrics
+func searchLyricsSpotify(ctx context.Context, query string) ([]*SearchResult, error) {
+	var found webSearch
+	params := url.Values{}
+	params.Set("q", query)
+	params.Set("type", "track")
+	params.Set("limit", strconv.Itoa(SPOTIFY_SEARCH_LIMIT))
+	if err := webAPIGet("search?"+params.Encode(), &found); err != nil {
+		return nil, err
+	}
+
+	tracks := found.Tracks.Items
+	ret := make([]*SearchResult, len(tracks))
+	indices := make([]int, len(tracks))
+	for i := range indices {
+		indices[i] = i
+	}
+	forEachConcurrent(ctx, indices, SPOTIFY_SEARCH_LIMIT, func(i int) {
+		meta := tracks[i].metadata("")
+		data := &LyricsData{
+			TrackID: tracks[i].ID,
+			Artist:  meta.Artist,
+			Title:   meta.Title,
+			Album:   meta.Album,
+			Length:  meta.Length,
+		}
+		if err := data.fetchLyricsSpotify(ctx); err != nil {
+			log(fmt.Sprintf("Error fetching lyrics of %s: %v", tracks[i].ID, err))
+		}
+		ret[i] = &SearchResult{LyricsData: data}
+	})
+	// tracks without lyrics are of no use
+	return slices.DeleteFunc(ret, func(r *SearchResult) bool {
+		return r == nil || len(r.Lyrics) == 0
+	}), nil
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// BatchReport summarizes a fetch-batch run
+type BatchReport struct {
+	Total    int `json:"total"`
+	Cached   int `json:"cached"` // found in the cache, not fetched again
+	Found    int `json:"found"`
+	Synced   int `json:"synced"`
+	Unsynced int `json:"unsynced"`
+	NotFound int `json:"notFound"` // 404
+	Failed   int `json:"failed"`
+	Skipped  int `json:"skipped"` // not processed because the run was interrupted
+}
+
+func (r *BatchReport) String() string {
+	s := fmt.Sprintf("%d tracks: %d found (%d synced, %d unsynced), %d not found, %d failed, %d taken from the cache",
+		r.Total, r.Found, r.Synced, r.Unsynced, r.NotFound, r.Failed, r.Cached)
+	if r.Skipped > 0 {
+		s += fmt.Sprintf(", %d skipped", r.Skipped)
+	}
+	return s
+}
+
+// parses a line of fetch-batch input: a Spotify track ID, URI or URL, or
+// tab separated artist, title, duration (seconds or m:ss) and optionally album
+func parseBatchLine(line string) (*TrackMetadata, error) {
+	if !strings.Contains(line, "\t") {
+		id, err := parseSpotifyRef(line, "track")
+		if err != nil {
+			return nil, err
+		}
+		return &TrackMetadata{MprisID: "spotify:track:" + id}, nil
+	}
+	fields := strings.Split(line, "\t")
+	if len(fields) < 3 || len(fields) > 4 {
+		return nil, fmt.Errorf("expected artist, title, duration and optionally album, got %d fields", len(fields))
+	}
+	length, err := parseDuration(fields[2])
+	if err != nil {
+		return nil, err
+	}
+	meta := &TrackMetadata{
+		Artist: strings.TrimSpace(fields[0]),
+		Title:  strings.TrimSpace(fields[1]),
+		Length: length,
+	}
+	if len(fields) == 4 {
+		meta.Album = strings.TrimSpace(fields[3])
+	}
+	if meta.Title == "" {
+		return nil, fmt.Errorf("empty title")
+	}
+	return meta, nil
+}
+
+// parses seconds or m:ss into milliseconds
+func parseDuration(s string) (int, error) {
+	s = strings.TrimSpace(s)
+	mins, sec, hasMin := strings.Cut(s, ":")
+	if !hasMin {
+		mins, sec = "0", s
+	}
+	m, err1 := strconv.Atoi(mins)
+	secs, err2 := strconv.ParseFloat(sec, 64)
+	if err1 != nil || err2 != nil || m < 0 || secs < 0 || (hasMin && secs >= 60) {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	return m*60000 + int(secs*1000), nil
+}
+
+// reads fetch-batch input, see parseBatchLine. Empty lines and lines
+// starting with # are ignored.
+func readBatchInput(r io.Reader) ([]*TrackMetadata, error) {
+	var tracks []*TrackMetadata
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		meta, err := parseBatchLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", n, err)
+		}
+		tracks = append(tracks, meta)
+	}
+	return tracks, scanner.Err()
+}
+
+// "Artist - Title.lrc" with characters that are invalid in file names
+// replaced, numbered like "Artist - Title (2).lrc" if another track has the
+// same name. taken maps the names given so far to the cache keys of their
+// tracks.
+func batchFileName(data *LyricsData, taken map[string]string) string {
+	base := strings.TrimLeft(strings.Map(func(r rune) rune {
+		if r < 0x20 || strings.ContainsRune(`/\:*?"<>|`, r) {
+			return '_'
+		}
+		return r
+	}, data.Artist+" - "+data.Title), ".")
+	name := base + ".lrc"
+	for n := 2; taken[name] != "" && taken[name] != data.TrackID; n++ {
+		name = fmt.Sprintf("%s (%d).lrc", base, n)
+	}
+	taken[name] = data.TrackID
+	return name
+}
+
+// BatchOptions controls how fetch-batch fetches and exports lyrics
+type BatchOptions struct {
+	Jobs      int              // concurrent fetches
+	OutputDir string           // where LRC files are written, "" for none
+	Secondary string           // secondary text of the LRC files, see withSecondary
+	Filter    *ProfanityFilter // applied to the LRC files, may be nil
+}
+
+// fetches the lyrics of all tracks through the cache with at most opts.Jobs
+// fetches at a time. Tracks already in the cache are not fetched again,
+// so an interrupted run can simply be restarted. If opts.OutputDir is set,
+// the lyrics are also written there as LRC files.
+func fetchBatch(ctx context.Context, cache *Cache, tracks []*TrackMetadata, opts BatchOptions) *BatchReport {
+	var (
+		mu     sync.Mutex
+		report = &BatchReport{Total: len(tracks)}
+		done   int
+		files  = map[string]string{} // see batchFileName
+	)
+	forEachConcurrent(ctx, tracks, opts.Jobs, func(meta *TrackMetadata) {
+		data, cached, err := fetchBatchTrack(ctx, cache, meta)
+		if ctx.Err() != nil {
+			return // counted as skipped
+		}
+		if err == nil && opts.OutputDir != "" && !data.IsError && len(data.Lyrics) > 0 {
+			mu.Lock()
+			path := filepath.Join(opts.OutputDir, batchFileName(data, files))
+			mu.Unlock()
+			export, err := withSecondary(ctx, opts.Secondary, data)
+			if err != nil {
+				log(err.Error())
+			}
+			if err := opts.Filter.Apply(export).lrcEncodeFile(path); err != nil {
+				log(fmt.Sprintf("Error writing %s: %v", path, err))
+			}
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		done++
+		state := "failed"
+		switch {
+		case err != nil || data.IsError && !data.Is404:
+			report.Failed++
+		case data.Is404 || len(data.Lyrics) == 0:
+			report.NotFound++
+			state = "not found"
+		case data.IsLineSynced:
+			report.Found++
+			report.Synced++
+			state = "synced"
+		default:
+			report.Found++
+			report.Unsynced++
+			state = "unsynced"
+		}
+		if cached {
+			report.Cached++
+		}
+		name := meta.MprisID
+		if data != nil && data.Title != "" {
+			name = data.Artist + " - " + data.Title
+		}
+		log(fmt.Sprintf("[%d/%d] %s: %s", done, report.Total, name, state))
+	})
+	report.Skipped = report.Total - done
+	return report
+}
+
+func fetchBatchTrack(ctx context.Context, cache *Cache, meta *TrackMetadata) (*LyricsData, bool, error) {
+	key, err := cache.KeyFor(meta)
+	if err != nil {
+		return nil, false, err
+	}
+	if data := loadCachedData(cache, key); data != nil {
+		return data, true, nil
+	}
+	data, err := fetchShared(ctx, cache, key, meta, nil)
+	if err != nil && ctx.Err() == nil {
+		// the error state was cached, report it like one read from the cache
+		if rec, err := cache.Load(key); err == nil {
+			return rec.LyricsData(), false, nil
+		}
+	}
+	return data, false, err
+}
+
+func openBatchInput(path string) (io.ReadCloser, error) {
+	if path == "-" {
+		return io.NopCloser(os.Stdin), nil
+	}
+	return os.Open(path)
+}
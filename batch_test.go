@@ -0,0 +1,22 @@
+package main
+
+import "testing"
+
+func TestBatchFileName(t *testing.T) {
+	taken := map[string]string{}
+	cases := []struct {
+		data LyricsData
+		want string
+	}{
+		{LyricsData{TrackID: "a", Artist: "Artist", Title: "Song"}, "Artist - Song.lrc"},
+		{LyricsData{TrackID: "b", Artist: "Artist", Title: "Song"}, "Artist - Song (2).lrc"},
+		{LyricsData{TrackID: "a", Artist: "Artist", Title: "Song"}, "Artist - Song.lrc"},
+		{LyricsData{TrackID: "c", Artist: "Artist", Title: "Song"}, "Artist - Song (3).lrc"},
+		{LyricsData{TrackID: "d", Artist: "AC/DC", Title: "What?"}, "AC_DC - What_.lrc"},
+	}
+	for _, c := range cases {
+		if got := batchFileName(&c.data, taken); got != c.want {
+			t.Errorf("batchFileName(%s: %s - %s) = %q, want %q", c.data.TrackID, c.data.Artist, c.data.Title, got, c.want)
+		}
+	}
+}
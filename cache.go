@@ -0,0 +1,470 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Version of the cache record format, bump when changing CacheRecord in an
+// incompatible way and handle the old version in Cache.Load
+const CACHE_FORMAT_VERSION = 1
+
+var errUserEdited = errors.New("cache entry was edited by the user, not overwriting")
+
+// CacheRecord is what is stored for each track in the lyrics cache
+type CacheRecord struct {
+	Version    int         `json:"version"`
+	TrackID    string      `json:"trackId"`
+	Artist     string      `json:"artist"`
+	Title      string      `json:"title"`
+	Album      string      `json:"album"`
+	Length     int         `json:"lengthMs"`
+	State      string      `json:"state"`              // ok, 404 or error
+	SyncType   string      `json:"syncType,omitempty"` // line or unsynced
+	Provider   string      `json:"provider,omitempty"`
+	FetchedAt  int64       `json:"fetchedAt"`            // unix timestamp
+	Hash       string      `json:"hash,omitempty"`       // of the lyrics, see lyricsHash
+	UserEdited bool        `json:"userEdited,omitempty"` // never overwritten by automatic fetches
+	Offset     int         `json:"offset,omitempty"`
+	Lyrics     []LyricLine `json:"lyrics,omitempty"`
+}
+
+func lyricsHash(lyrics []LyricLine) string {
+	h := sha256.New()
+	for _, line := range lyrics {
+		fmt.Fprintln(h, lrcEncodeLine(line))
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil))
+}
+
+func NewCacheRecord(data *LyricsData) *CacheRecord {
+	rec := &CacheRecord{
+		Version:    CACHE_FORMAT_VERSION,
+		TrackID:    data.TrackID,
+		Artist:     data.Artist,
+		Title:      data.Title,
+		Album:      data.Album,
+		Length:     data.Length,
+		Provider:   data.Provider,
+		FetchedAt:  data.FetchTime,
+		UserEdited: data.UserEdited,
+		Offset:     data.Offset,
+	}
+	if rec.FetchedAt == 0 {
+		rec.FetchedAt = time.Now().Unix()
+	}
+	switch {
+	case data.Is404:
+		rec.State = "404"
+	case data.IsError:
+		rec.State = "error"
+	default:
+		rec.State = "ok"
+		rec.SyncType = "unsynced"
+		if data.IsLineSynced {
+			rec.SyncType = "line"
+		}
+		rec.Lyrics = data.Lyrics
+		rec.Hash = lyricsHash(data.Lyrics)
+	}
+	return rec
+}
+
+func (rec *CacheRecord) LyricsData() *LyricsData {
+	return &LyricsData{
+		TrackID:      rec.TrackID,
+		Artist:       rec.Artist,
+		Title:        rec.Title,
+		Album:        rec.Album,
+		Length:       rec.Length,
+		IsLineSynced: rec.SyncType == "line",
+		IsError:      rec.State != "ok",
+		Is404:        rec.State == "404",
+		Offset:       rec.Offset,
+		Provider:     rec.Provider,
+		FetchTime:    rec.FetchedAt,
+		UserEdited:   rec.UserEdited,
+		Lyrics:       rec.Lyrics,
+	}
+}
+
+// one of synced, unsynced, 404 or error
+func (rec *CacheRecord) DisplayState() string {
+	switch {
+	case rec.State != "ok":
+		return rec.State
+	case rec.SyncType == "line":
+		return "synced"
+	default:
+		return "unsynced"
+	}
+}
+
+// error states are refetched after a while
+func (rec *CacheRecord) Expired(now time.Time) bool {
+	age := now.Unix() - rec.FetchedAt
+	return (rec.State == "error" && age >= int64(REFETCH_INTERVAL_SEC)) ||
+		(rec.State == "404" && age >= int64(REFETCH_INTERVAL_SEC_404))
+}
+
+// Cache stores one CacheRecord per track as JSON in <dir>/lyrics. Files of
+// the old format (<dir>/<key>.lrc) are migrated when they are first read.
+// All reads and writes of cached lyrics go through here.
+type Cache struct {
+	dir string
+}
+
+func NewCache(cacheDir string) *Cache {
+	return &Cache{dir: cacheDir}
+}
+
+func (c *Cache) recordDir() string {
+	return filepath.Join(c.dir, "lyrics")
+}
+
+func (c *Cache) path(key string) string {
+	return filepath.Join(c.recordDir(), key+".json")
+}
+
+func (c *Cache) legacyPath(key string) string {
+	return filepath.Join(c.dir, key+".lrc")
+}
+
+// takes the lock of the given key, writers take it exclusively so that
+// read-modify-write cycles of concurrent processes don't get lost
+func (c *Cache) lock(key string, exclusive bool) (func(), error) {
+	lockDir := filepath.Join(c.dir, "locks")
+	if err := os.MkdirAll(lockDir, 0755); err != nil {
+		return nil, fmt.Errorf("error creating lock directory: %v", err)
+	}
+	unlock, err := flockFile(filepath.Join(lockDir, key+".lock"), exclusive)
+	if err != nil {
+		return nil, fmt.Errorf("error locking cache entry %s: %v", key, err)
+	}
+	return unlock, nil
+}
+
+// loads the record of the given key, returns an error satisfying
+// os.IsNotExist if there is none
+func (c *Cache) Load(key string) (*CacheRecord, error) {
+	unlock, err := c.lock(key, false)
+	if err != nil {
+		return nil, err
+	}
+	rec, err := c.read(key)
+	unlock()
+	if !os.IsNotExist(err) {
+		return rec, err
+	}
+
+	// there might be an old file to migrate, which is a write
+	unlock, err = c.lock(key, true)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	return c.load(key)
+}
+
+// like Load, but the caller must hold the lock exclusively
+func (c *Cache) load(key string) (*CacheRecord, error) {
+	rec, err := c.read(key)
+	if os.IsNotExist(err) {
+		return c.migrate(key)
+	}
+	return rec, err
+}
+
+func (c *Cache) read(key string) (*CacheRecord, error) {
+	content, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, err
+	}
+	var rec CacheRecord
+	if err := json.Unmarshal(content, &rec); err != nil {
+		return nil, fmt.Errorf("error parsing cache record: %v", err)
+	}
+	if rec.Version > CACHE_FORMAT_VERSION {
+		return nil, fmt.Errorf("cache record has unsupported version %d", rec.Version)
+	}
+	if rec.TrackID == "" {
+		rec.TrackID = key
+	}
+	return &rec, nil
+}
+
+// converts a cache file of the old format, if any
+func (c *Cache) migrate(key string) (*CacheRecord, error) {
+	legacy := c.legacyPath(key)
+	info, err := os.Stat(legacy)
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(legacy)
+	if err != nil {
+		return nil, err
+	}
+	data, err := decodeCache(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("error migrating %s: %v", legacy, err)
+	}
+	data.TrackID = key
+	if data.FetchTime == 0 {
+		data.FetchTime = info.ModTime().Unix()
+	}
+	rec := NewCacheRecord(data)
+	if err := c.write(key, rec); err != nil {
+		return nil, fmt.Errorf("error migrating %s: %v", legacy, err)
+	}
+	os.Remove(legacy)
+	log(fmt.Sprintf("Migrated cache file %s", legacy))
+	return rec, nil
+}
+
+func (c *Cache) write(key string, rec *CacheRecord) error {
+	rec.Version = CACHE_FORMAT_VERSION
+	if rec.State == "ok" {
+		rec.Hash = lyricsHash(rec.Lyrics)
+	}
+	content, err := json.MarshalIndent(rec, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding cache record: %v", err)
+	}
+	if err := os.MkdirAll(c.recordDir(), 0755); err != nil {
+		return fmt.Errorf("error creating cache directory: %v", err)
+	}
+	return writeFileAtomic(c.path(key), content, 0644)
+}
+
+// stores a record, refusing to replace a user-edited one with one that is not
+func (c *Cache) Store(key string, rec *CacheRecord) error {
+	unlock, err := c.lock(key, true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if !rec.UserEdited {
+		if old, err := c.load(key); err == nil && old.UserEdited {
+			return errUserEdited
+		}
+	}
+	return c.write(key, rec)
+}
+
+// stores lyrics chosen or edited by the user, which automatic fetches never
+// overwrite
+func (c *Cache) StoreUserEdited(key string, data *LyricsData) error {
+	edited := *data
+	edited.TrackID = key
+	edited.UserEdited = true
+	edited.IsError, edited.Is404 = false, false
+	edited.FetchTime = time.Now().Unix()
+	return c.Store(key, NewCacheRecord(&edited))
+}
+
+// loads, modifies and stores a record while holding its lock
+func (c *Cache) Update(key string, modify func(rec *CacheRecord) error) error {
+	unlock, err := c.lock(key, true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	rec, err := c.load(key)
+	if err != nil {
+		return err
+	}
+	if err := modify(rec); err != nil {
+		return err
+	}
+	return c.write(key, rec)
+}
+
+func (c *Cache) Remove(key string) error {
+	unlock, err := c.lock(key, true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	err = os.Remove(c.path(key))
+	if lerr := os.Remove(c.legacyPath(key)); err != nil && lerr == nil {
+		err = nil // was not migrated yet
+	}
+	return err
+}
+
+// keys of all records, including not yet migrated ones
+func (c *Cache) Keys() ([]string, error) {
+	var keys []string
+	seen := map[string]bool{}
+	for _, pattern := range []string{c.path("*"), c.legacyPath("*")} {
+		paths, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range paths {
+			key := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys, nil
+}
+
+// size of the stored record in bytes
+func (c *Cache) Size(key string) int64 {
+	info, err := os.Stat(c.path(key))
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
+
+// an entry of the lyrics cache, as shown by `cache list`
+type CacheEntry struct {
+	TrackID   string    `json:"trackId"`
+	Artist    string    `json:"artist"`
+	Title     string    `json:"title"`
+	State     string    `json:"state"` // synced, unsynced, 404 or error
+	Provider  string    `json:"provider"`
+	FetchTime time.Time `json:"fetchTime"`
+	Size      int64     `json:"size"`
+	Edited    bool      `json:"userEdited"`
+}
+
+func (c *Cache) Entry(key string) (CacheEntry, *CacheRecord, error) {
+	rec, err := c.Load(key)
+	if err != nil {
+		return CacheEntry{}, nil, err
+	}
+	return CacheEntry{
+		TrackID:   key,
+		Artist:    rec.Artist,
+		Title:     rec.Title,
+		State:     rec.DisplayState(),
+		Provider:  rec.Provider,
+		FetchTime: time.Unix(rec.FetchedAt, 0),
+		Size:      c.Size(key),
+		Edited:    rec.UserEdited,
+	}, rec, nil
+}
+
+// all entries in the cache, oldest first. Unreadable records are skipped.
+func (c *Cache) Entries() ([]CacheEntry, error) {
+	keys, err := c.Keys()
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]CacheEntry, 0, len(keys))
+	for _, key := range keys {
+		entry, _, err := c.Entry(key)
+		if err != nil {
+			log(fmt.Sprintf("Skipping cache entry %s: %v", key, err))
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].FetchTime.Before(entries[j].FetchTime)
+	})
+	return entries, nil
+}
+
+// removes all records, but keeps the token, lock and log files
+func (c *Cache) Clear() (int, error) {
+	keys, err := c.Keys()
+	if err != nil {
+		return 0, err
+	}
+	for i, key := range keys {
+		if err := c.Remove(key); err != nil {
+			return i, err
+		}
+	}
+	return len(keys), nil
+}
+
+type PruneOptions struct {
+	OlderThan time.Duration // 0 for any age
+	State     string        // "" for any state
+	MaxSize   int64         // 0 for unlimited
+}
+
+// entries to be removed according to the options: those matching both the
+// age and the state filter (if any is given), then the oldest ones until the
+// rest fits into MaxSize
+func selectPrune(entries []CacheEntry, opts PruneOptions, now time.Time) []CacheEntry {
+	var pruned, kept []CacheEntry
+	filtered := opts.OlderThan > 0 || opts.State != ""
+	for _, entry := range entries {
+		if filtered &&
+			(opts.OlderThan == 0 || now.Sub(entry.FetchTime) > opts.OlderThan) &&
+			(opts.State == "" || entry.State == opts.State) {
+			pruned = append(pruned, entry)
+		} else {
+			kept = append(kept, entry)
+		}
+	}
+	if opts.MaxSize > 0 {
+		var total int64
+		for _, entry := range kept {
+			total += entry.Size
+		}
+		// entries are sorted oldest first
+		for len(kept) > 0 && total > opts.MaxSize {
+			total -= kept[0].Size
+			pruned = append(pruned, kept[0])
+			kept = kept[1:]
+		}
+	}
+	return pruned
+}
+
+// parses durations like "90m", "36h" or "30d"
+func parseAge(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid number of days: %v", err)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(s)
+}
+
+// parses sizes like "512", "100K", "10M" or "1G"
+func parseSize(s string) (int64, error) {
+	multipliers := map[string]int64{"K": 1 << 10, "M": 1 << 20, "G": 1 << 30}
+	mult := int64(1)
+	s = strings.TrimSuffix(strings.ToUpper(s), "B")
+	if m, ok := multipliers[s[max(len(s)-1, 0):]]; ok {
+		mult = m
+		s = s[:len(s)-1]
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid size: %s", s)
+	}
+	return n * mult, nil
+}
+
+func formatSize(size int64) string {
+	switch {
+	case size >= 1<<20:
+		return fmt.Sprintf("%.1fM", float64(size)/(1<<20))
+	case size >= 1<<10:
+		return fmt.Sprintf("%.1fK", float64(size)/(1<<10))
+	default:
+		return fmt.Sprintf("%dB", size)
+	}
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// Cache keys are Spotify track IDs when available. Other players report
+// track IDs like /org/mpris/MediaPlayer2/Track/3 that are neither unique
+// nor stable, so their tracks are keyed by a hash of the normalized
+// metadata instead ("m-" followed by 16 hex digits). Metadata keys are
+// also recorded as aliases of the Spotify ID, so the same song played in
+// another player maps to the same entry.
+
+var (
+	spotifyMprisIDRe = regexp.MustCompile(`^(?:/com/spotify/track/|spotify:track:)([0-9A-Za-z]{22})$`)
+	spotifyIDRe      = regexp.MustCompile(`^[0-9A-Za-z]{22}$`)
+	bracketsRe       = regexp.MustCompile(`\s*[(\[（【][^)\]）】]*[)\]）】]`)
+	versionSuffixRe  = regexp.MustCompile(`(?i)\s+-\s+.*\b(remaster(ed)?|version|edit|mix|live|mono|stereo)\b.*$`)
+	artistSepRe      = regexp.MustCompile(`(?i)\s*(,|&|;|/|\bfeat\.?|\bft\.?|\bwith\b)\s*`)
+)
+
+// extracts the Spotify track ID from an mpris:trackid
+func spotifyTrackID(mprisID string) (string, bool) {
+	matches := spotifyMprisIDRe.FindStringSubmatch(mprisID)
+	if matches == nil {
+		return "", false
+	}
+	return matches[1], true
+}
+
+func isSpotifyTrackID(key string) bool {
+	return spotifyIDRe.MatchString(key)
+}
+
+func normalizeMetadata(s string) string {
+	s = bracketsRe.ReplaceAllString(s, "")
+	s = versionSuffixRe.ReplaceAllString(s, "")
+	var b strings.Builder
+	space := false
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			if space && b.Len() > 0 {
+				b.WriteRune(' ')
+			}
+			b.WriteRune(r)
+			space = false
+		} else {
+			space = true
+		}
+	}
+	return b.String()
+}
+
+// only the first artist, as players join multiple artists differently
+func normalizeArtist(artist string) string {
+	return normalizeMetadata(artistSepRe.Split(artist, 2)[0])
+}
+
+func hashKey(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return "m-" + hex.EncodeToString(sum[:8])
+}
+
+// the full metadata key, and a looser one without the album since not all
+// players report it
+func metadataKeys(artist, title, album string, lengthMs int) []string {
+	a, t := normalizeArtist(artist), normalizeMetadata(title)
+	if t == "" {
+		return nil
+	}
+	dur := fmt.Sprint((lengthMs + 1000) / 2000) // rounded to 2 seconds
+	return []string{
+		hashKey(a, t, normalizeMetadata(album), dur),
+		hashKey(a, t, "", dur),
+	}
+}
+
+func (c *Cache) aliasFile() string {
+	return filepath.Join(c.dir, "aliases.json")
+}
+
+func (c *Cache) readAliases() map[string]string {
+	aliases := map[string]string{}
+	if content, err := os.ReadFile(c.aliasFile()); err == nil {
+		if err := json.Unmarshal(content, &aliases); err != nil {
+			log(fmt.Sprintf("Error parsing cache aliases: %v", err))
+		}
+	}
+	return aliases
+}
+
+func (c *Cache) exists(key string) bool {
+	_, err := os.Stat(c.path(key))
+	if err != nil {
+		_, err = os.Stat(c.legacyPath(key))
+	}
+	return err == nil
+}
+
+// points the given aliases to key, existing aliases to Spotify IDs are kept
+func (c *Cache) AddAliases(key string, aliases ...string) error {
+	unlock, err := c.lock("aliases", true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	all := c.readAliases()
+	changed := false
+	for _, alias := range aliases {
+		if alias == key || all[alias] == key {
+			continue
+		}
+		if old, ok := all[alias]; ok && isSpotifyTrackID(old) && !isSpotifyTrackID(key) && c.exists(old) {
+			continue
+		}
+		all[alias] = key
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	content, err := json.MarshalIndent(all, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(c.aliasFile(), content, 0644)
+}
+
+// the cache key of a track: its Spotify ID if it has one, otherwise
+// whatever an alias of its metadata keys points to, or the full metadata key
+func (c *Cache) KeyFor(meta *TrackMetadata) (string, error) {
+	if id, ok := spotifyTrackID(meta.MprisID); ok {
+		return id, nil
+	}
+	keys := metadataKeys(meta.Artist, meta.Title, meta.Album, meta.Length)
+	if len(keys) == 0 {
+		return "", fmt.Errorf("neither a Spotify track ID nor a title available")
+	}
+	aliases := c.readAliases()
+	for _, key := range keys {
+		if target, ok := aliases[key]; ok && c.exists(target) {
+			return target, nil
+		}
+	}
+	return keys[0], nil
+}
+
+// aliases to register for a cache entry
+func (data *LyricsData) aliases() []string {
+	return metadataKeys(data.Artist, data.Title, data.Album, data.Length)
+}
+
+// cache key of the track currently playing
+func getCurrentCacheKey(cacheDir string) (string, error) {
+	meta, err := getTrackMetadata()
+	if err != nil {
+		return "", err
+	}
+	return NewCache(cacheDir).KeyFor(meta)
+}
@@ -0,0 +1,7579 @@
+package main
+
+// OpenCC style conversion tables between Simplified and Traditional Chinese:
+// a phrase or character, a tab and its conversion on each line. The longest
+// match wins, see chineseConverter.convert. Generated from the rules of
+// ICU 72's Simplified-Traditional and Traditional-Simplified transliterators
+// (utrans_toRules), rules with context were left out.
+
+const chineseS2TTable = `
+于飞	于飛
+于归	于歸
+于思	于思
+单于	單于
+鲜于	鮮于
+姜片	薑片
+姜末	薑末
+生姜	生薑
+鬼子姜	鬼子薑
+姜	姜
+赤皮仑	赤皮崙
+金仑溪	金崙溪
+下仑	下崙
+中仑	中崙
+仑顶	崙頂
+昆山	崑山
+昆仑	崑崙
+昆曲	崑曲
+仑	侖
+曲	曲
+茶余饭后	茶餘飯後
+余怒未消	餘怒未消
+余音绕梁	餘音繞梁
+余勇可贾	餘勇可賈
+余波	餘波
+余地	餘地
+余额	餘額
+余角	餘角
+余烬	餘燼
+余款	餘款
+余量	餘量
+余粮	餘糧
+余年	餘年
+余力	餘力
+余生	餘生
+余兴	餘興
+余切	餘切
+余庆	餘慶
+余裕	餘裕
+余杭	餘杭
+余孽	餘孽
+余音	餘音
+余钱	餘錢
+余震	餘震
+结余	結餘
+多余	多餘
+有余	有餘
+残余	殘餘
+剩余	剩餘
+其余	其餘
+业余	業餘
+盈余	盈餘
+余	余
+馀	餘
+什么	甚麼
+么	麼
+复数	複數
+复分数	複分數
+复杂	複雜
+复制	複製
+复	復
+了然	瞭然
+了解	瞭解
+了望	瞭望
+明了	明瞭
+了	了
+解铃系铃	解鈴繫鈴
+系词	繫詞
+系辞	繫辭
+系念	繫念
+关系	關係
+联系	聯繫
+系于	繫於
+维系	維繫
+连系	連繫
+系	系
+糊里糊涂	糊裡糊塗
+稀里糊涂	稀裡糊塗
+蒙在鼓里	蒙在鼓裡
+怪里怪气	怪裡怪氣
+傻里傻气	傻裡傻氣
+俗里俗气	俗裡俗氣
+嗲里嗲气	嗲裡嗲氣
+女里女气	女裡女氣
+妖里妖气	妖裡妖氣
+娇里娇气	嬌裡嬌氣
+洋里洋气	洋裡洋氣
+宝里宝气	寶裡寶氣
+土里土气	土裡土氣
+稀里哗啦	稀裡嘩啦
+由表及里	由表及裡
+百里挑一	百裡挑一
+白里透红	白裡透紅
+私下里	私下裡
+骨子里	骨子裡
+窝里斗	窩裡鬥
+忙里	忙裡
+闻里	聞裡
+死里	死裡
+海里	海裡
+浪里	浪裡
+山里	山裡
+地里	地裡
+花里	花裡
+田里	田裡
+沟里	溝裡
+河里	河裡
+江里	江裡
+沙里	沙裡
+雪里	雪裡
+风里	風裡
+雨里	雨裡
+雾里	霧裡
+夜里	夜裡
+省里	省裡
+镇里	鎮裡
+城里	城裡
+市里	市裡
+村里	村裡
+站里	站裡
+段里	段裡
+厂里	廠裡
+店里	店裡
+馆里	館裡
+部里	部裡
+队里	隊裡
+班里	班裡
+关里	關裡
+门里	門裡
+家里	家裡
+屋里	屋裡
+房里	房裡
+连里	連裡
+院里	院裡
+行里	行裡
+园里	園裡
+苑里	苑裡
+明里	明裡
+暗里	暗裡
+字里	字裡
+表里	表裡
+內里	內裡
+手里	手裡
+眼里	眼裡
+嘴里	嘴裡
+口里	口裡
+心里	心裡
+头里	頭裡
+怀里	懷裡
+肚里	肚裡
+这里	這裡
+那里	那裡
+哪里	哪裡
+入里	入裡
+里里外外	裡裡外外
+里应外合	裡應外合
+里通外国	裡通外國
+里衬	裡襯
+里子	裡子
+里海	裡海
+里手	裡手
+里快	裡快
+里面	裡面
+里层	裡層
+里间	裡間
+里屋	裡屋
+里头	裡頭
+里边	裡邊
+里外	裡外
+干什么	幹甚麼
+干部	幹部
+干才	幹才
+干道	幹道
+干劲	幹勁
+干练	幹練
+干吗	幹嗎
+干事	幹事
+干线	幹線
+包干	包幹
+才干	才幹
+高干	高幹
+骨干	骨幹
+苦干	苦幹
+脑干	腦幹
+能干	能幹
+强干	強幹
+实干	實幹
+树干	樹幹
+调干	單幹
+枝干	枝幹
+主干	主幹
+干贝	干貝
+干犯	干犯
+干戈	干戈
+干涉	干涉
+干支	干支
+干连	干連
+干扰	干擾
+干预	干預
+干系	干系
+若干	若干
+天干	天干
+无干	無干
+相干	相干
+干面	乾麵
+乾坤	乾坤
+干	乾
+划时代	劃時代
+划分	劃分
+划开	劃開
+划一	劃一
+划界	劃界
+划策	劃策
+划归	劃歸
+划清	劃清
+规划	規劃
+策划	策劃
+筹划	籌劃
+计划	計劃
+比划	比劃
+谋划	謀劃
+区划	區劃
+版画	版畫
+划	划
+画	畫
+斗量	斗量
+斗胆	斗胆
+斗室	斗室
+斗城	斗城
+斗拱	斗拱
+斗子	斗子
+阿斗	阿斗
+北斗	北斗
+漏斗	漏斗
+熨斗	熨斗
+南斗	南斗
+墨斗	墨斗
+市斗	市斗
+泰斗	泰斗
+星斗	星斗
+斗	鬥
+周末	週末
+周刊	週刊
+周期	週期
+周岁	週歲
+周年	週年
+周游	周遊
+周转	週轉
+本周	本週
+周	周
+松球	松毬
+球花	毬花
+球果	毬果
+松动	鬆動
+松紧	鬆緊
+松散	鬆散
+松手	鬆手
+松软	鬆軟
+松绑	鬆綁
+松弛	鬆弛
+疏松	酥鬆
+放松	放鬆
+轻松	輕鬆
+肉松	肉鬆
+松	松
+果	果
+老板	老闆
+板	板
+面条	麵條
+面粉	麵粉
+面包	麵包
+面筋	麵筋
+面食	麵食
+炸酱面	炸醬麵
+担担面	擔擔麵
+刀削面	刀削麵
+空心面	空心麵
+阳春面	陽春麵
+甜面酱	甜麵醬
+炒面	炒麵
+擀面	擀麵
+拉面	拉麵
+凉面	涼麵
+汤面	湯麵
+寿面	壽麵
+碱面	鹼麵
+发面	發麵
+白面	白麵
+面	面
+防御	防禦
+御敌	禦敵
+御寒	禦寒
+御	御
+腼腆	靦腆
+䩄	靦
+宫商角徵羽	宮商角徵羽
+征伐	征伐
+征服	征服
+征途	征途
+征讨	征討
+长征	長征
+出征	出征
+亲征	親征
+征	徵
+台风	颱風
+写字台	寫字檯
+台	台
+胡同	衚衕
+胡子	鬍子
+胡须	鬍鬚
+胡	胡
+须根	鬚根
+须鲸	鬚鯨
+须眉	鬚眉
+龙须	龍鬚
+触须	觸鬚
+须	須
+形单影只	形單影隻
+只身	隻身
+只眼	隻眼
+船只	船隻
+舰只	艦隻
+祇	祇
+只	只
+并发	併發
+并拢	併攏
+并入	併入
+并吞	併吞
+并力	併力
+合并	合併
+吞并	吞併
+并	並
+当	當
+药	藥
+布道	佈道
+布景	佈景
+布局	佈局
+布雷	佈雷
+布施	佈施
+布置	佈置
+布谷	布穀
+发布	發佈
+公布	公佈
+宣布	宣佈
+布	布
+开天辟地	開天闢地
+开辟	開闢
+辟邪	闢邪
+辟	辟
+人言藉藉	人言藉藉
+借口	藉口
+借故	藉故
+借使	藉使
+凭藉	憑藉
+骀藉	駘藉
+慰借	慰藉
+狼藉	狼藉
+蕴藉	藴藉
+枕藉	枕藉
+借	借
+藉	藉
+尽管	儘管
+尽	盡
+叶韵	叶韻
+叶	葉
+伙计	夥計
+伙伴	夥伴
+伙	伙
+家具	傢具
+家伙	傢伙
+家	家
+奸夫	姦夫
+奸妇	姦婦
+奸情	姦情
+奸污	姦污
+奸淫	姦淫
+鸡奸	雞姦
+轮奸	輪姦
+强奸	強姦
+通奸	通姦
+诱奸	誘姦
+奸	奸
+历书	曆書
+历法	曆法
+公历	公曆
+旧历	舊曆
+黄历	黃曆
+日历	日曆
+西历	西曆
+夏历	夏曆
+新历	新曆
+阳历	陽曆
+阴历	陰曆
+月历	月曆
+游历	遊歷
+万年历	萬年曆
+万历	萬曆
+历	歷
+万俟	万俟
+气冲冲	氣沖沖
+气焰	氣燄
+气	氣
+细致	細緻
+精致	精緻
+标致	標緻
+别致	別緻
+致	致
+制版	製版
+制成	製成
+制品	製品
+制片	製片
+制造	製造
+制图	製圖
+制作	製作
+缝制	縫製
+巨制	巨製
+炼制	煉製
+酿制	釀製
+炮制	炮製
+特制	特製
+预制	預製
+制	制
+谷贱伤农	穀賤傷農
+谷神星	穀神星
+鬼谷子	鬼谷子
+谷子	穀子
+打谷	打穀
+谷场	穀場
+谷物	穀物
+谷粒	穀粒
+谷类	穀類
+谷草	穀草
+谷仓	穀倉
+谷苗	穀苗
+谷种	穀種
+谷穗	穀穗
+谷壳	穀殻
+包谷	包穀
+稻谷	稻穀
+五谷	五穀
+米谷	米穀
+秕谷	秕穀
+晒谷	曬穀
+谷	谷
+后妃	后妃
+后稷	后稷
+后土	后土
+后羿	后羿
+皇后	皇后
+母后	母后
+王后	王后
+太后	太后
+后	後
+地方志	地方誌
+标志	標誌
+墓志	墓誌
+日志	日誌
+碑志	碑誌
+县志	縣誌
+杂志	雜誌
+志	志
+别扭	彆扭
+别	別
+汇报	彙報
+词汇	詞彙
+字汇	字彙
+汇	匯
+辞	辭
+词	詞
+机	機
+发廊	髮廊
+发妻	髮妻
+发型	髮型
+发困	發睏
+卷土重来	捲土重來
+卷心菜	捲心菜
+卷铺盖	捲鋪蓋
+卷尺	捲尺
+卷入	捲入
+卷动	捲動
+卷成	捲成
+卷曲	捲曲
+卷款	捲款
+卷帘	捲簾
+卷纸	捲紙
+卷缩	捲縮
+卷舌	捲舌
+卷袖	捲袖
+卷走	捲走
+卷起	捲起
+卷门	捲門
+卷云	捲雲
+卷须	捲鬚
+春卷	春捲
+烟卷	煙捲
+纸卷	紙捲
+卷轴	捲軸
+席卷	席捲
+舒卷	舒捲
+风卷残云	風捲殘雲
+风驰电卷	風馳電捲
+龙卷风	龍捲風
+蛋卷	蛋捲
+朱卷	硃卷
+怒发冲冠	怒髮衝冠
+长发	長髮
+短发	短髮
+白发	白髮
+黑发	黑髮
+金发	金髮
+红发	紅髮
+銀发	銀髮
+染发	染髮
+编发	編髮
+毫发	毫髮
+护发	護髮
+假发	假髮
+结发	結髮
+卷发	捲髮
+理发	理髮
+落发	落髮
+毛发	毛髮
+美发	美髮
+散发	散髮
+烫发	燙髮
+势头	勢頭
+头发	頭髮
+秀发	秀髮
+剃髮	剃发
+一发	一髮
+发	發
+人云亦云	人云亦云
+不知所云	不知所云
+云游	雲遊
+云	雲
+子丑寅卯	子丑寅卯
+生旦淨末丑	生旦净末丑
+丑时	丑時
+丑旦	丑旦
+丑角	丑角
+小丑	小丑
+丑	醜
+萝卜	蘿蔔
+卜	卜
+冲茶	沖茶
+冲淡	沖淡
+冲服	沖服
+冲积	沖積
+冲凉	沖涼
+冲天	沖天
+冲绳	沖繩
+冲洗	沖洗
+冲毁	沖毀
+喜冲冲	喜沖沖
+冲	衝
+出游	出遊
+出	出
+线	線
+核实	覈實
+核算	覈算
+核	核
+回路	迴路
+回廊	迴廊
+回游	回遊
+萦回	縈迴
+迂回	迂迴
+回	回
+冬冬	鼕鼕
+冬	冬
+咸菜	鹹菜
+咸	咸
+清心寡欲	清心寡慾
+克欲修行	克慾修行
+欲不可纵	慾不可縱
+人之大欲	人之大慾
+求知欲	求知慾
+欲火	慾火
+欲望	慾望
+禁欲	禁慾
+利欲	利慾
+情欲	情慾
+肉欲	肉慾
+色欲	色慾
+食欲	食慾
+私欲	私慾
+兽欲	獸慾
+纵欲	縱慾
+性欲	性慾
+六欲	六慾
+嗜欲	嗜慾
+欲	欲
+准绳	準繩
+准时	準時
+准头	準頭
+准备	準備
+准确	準確
+为准	為準
+标准	標準
+标签	標籤
+水准	水準
+基准	基準
+对准	對準
+准	准
+标	標
+注册	註冊
+注销	註銷
+注解	註解
+注疏	註疏
+评注	評註
+附注	附註
+加注	加註
+注	注
+凶暴	兇暴
+凶器	兇器
+凶手	兇手
+元凶	元兇
+正凶	正兇
+逞凶	逞兇
+凶	凶
+扬	揚
+飏	颺
+宴	宴
+䜩	讌
+咬	咬
+豆	豆
+韭	韭
+笺	箋
+团	團
+卤鸡	滷雞
+卤味	滷味
+卤菜	滷菜
+茶卤	茶滷
+盐卤	鹽滷
+卤	鹵
+呆	呆
+泛	泛
+妫	媯
+众	眾
+钩	鈎
+绱	緔
+锐	銳
+赝	贋
+赃	贓
+粗	粗
+关	關
+饥	飢
+款	款
+胧	朧
+蒙	蒙
+骂	罵
+五脏	五臟
+六脏	六臟
+内脏	內臟
+心脏	心臟
+牝脏	牝臟
+肝脏	肝臟
+肺脏	肺臟
+肾脏	腎臟
+胃脏	胃臟
+胰脏	胰臟
+脏器	臟器
+脏毒	臟毒
+脏气	臟氣
+脏症	臟症
+脏腑	臟腑
+脏象	臟象
+脏躁	臟躁
+脾脏	脾臟
+脏	髒
+鳄	鰐
+凫	鳧
+鸡	雞
+赍	賫
+筘	筘
+吣	唚
+群	群
+叹	嘆
+剃	剃
+颓	頹
+颜	顏
+炮	炮
+启	啓
+茶几	茶几
+几	幾
+德	德
+悫	愨
+克	克
+坛坛罐罐	罈罈罐罐
+瓶瓶坛坛	瓶瓶罈罈
+醋坛	醋罈
+坛子	罈子
+酒坛	酒罈
+坛	壇
+升华	昇華
+毕升	畢昇
+高升	高昇
+歌舞升平	歌舞昇平
+升	升
+伪	偽
+收获	收穫
+获	獲
+绦	縧
+绣	繡
+钵	鉢
+蜡	蠟
+采薪之忧	采薪之憂
+兴高采烈	興高采烈
+无精打采	無精打采
+采风	采風
+风采	風采
+精采	精采
+神采	神采
+多采	多采
+喝采	喝采
+采缉	采緝
+彩	彩
+䌽	綵
+采	採
+厕	廁
+捣	搗
+沩	溈
+为	為
+产	產
+瘘	瘻
+灶	灶
+绝	絕
+绿	綠
+绷	繃
+凼	凼
+床	床
+墙	牆
+奖	獎
+眦	眥
+秆	稈
+耻	恥
+苧	薴
+苹	蘋
+蕴	蘊
+说	說
+谣	謠
+谫	謭
+竖	竪
+酝	醖
+录	錄
+锈	鏽
+镢	鐝
+阅	閱
+妆	妝
+闲静	閑靜
+闲居	閑居
+闲	閒
+游山玩水	遊山玩水
+游伴	遊伴
+游程	遊程
+游春	遊春
+游方	遊方
+游记	遊記
+游街	遊街
+游客	遊客
+游乐	遊樂
+游廊	遊廊
+游牧	遊牧
+游人	遊人
+游子	遊子
+游侠	遊俠
+游民	遊民
+游荡	遊蕩
+游说	遊說
+游仙	遊仙
+游憩	遊憩
+游闲	遊閑
+游戏	遊戲
+游手	遊手
+游魂	遊魂
+游猎	遊獵
+游玩	遊玩
+游园	遊園
+游遍	遊遍
+游兴	遊興
+游舫	遊舫
+游艇	遊艇
+游艺	遊藝
+游行	遊行
+游览	遊覽
+游逛	遊逛
+游医	遊醫
+游学	遊學
+畅游	暢遊
+串游	串遊
+春游	春遊
+导游	導遊
+交游	交遊
+郊游	郊遊
+倦游	倦遊
+冶游	冶遊
+漫游	漫遊
+梦游	夢遊
+嬉游	嬉遊
+巡游	巡遊
+环游	環遊
+旅游	旅遊
+浪游	浪遊
+神游	神遊
+秋游	秋遊
+仙游	仙遊
+遨游	遨遊
+野游	野遊
+夜游	夜遊
+游	游
+表蒙子	錶蒙子
+表带	錶帶
+表链	錶鏈
+表盘	錶盤
+表针	錶針
+电子表	電子錶
+电度表	電鍍錶
+防水表	防水錶
+马蹄表	馬蹄錶
+夜光表	夜光錶
+挂表	掛錶
+怀表	懷錶
+秒表	秒錶
+马表	馬錶
+钟表	鐘錶
+跑表	跑錶
+手表	手錶
+停表	停錶
+表	表
+症结	癥結
+症	症
+痴	痴
+白洋淀	白洋淀
+荷花淀	荷花淀
+水淀	水淀
+海淀	海淀
+东淀	東淀
+淀	澱
+向导	嚮導
+向往	嚮往
+向	向
+扎营	紮營
+驻扎	駐紮
+扎	扎
+占卜	占卜
+占卦	占卦
+占梦	占夢
+占星	占星
+占	佔
+托名	託名
+托收	託收
+信托	信託
+委托	委託
+拜托	拜託
+付托	付託
+寄托	寄託
+请托	請託
+受托	受託
+依托	依託
+嘱托	囑託
+转托	轉託
+托	托
+讬	託
+涌	湧
+累	累
+困惫	睏憊
+困乏	睏乏
+困	困
+左邻右舍	左鄰右舍
+舍利	舍利
+舍弟	舍弟
+宿舍	宿舍
+屋舍	屋舍
+田舍	田舍
+校舍	校舍
+民舍	民舍
+茅舍	茅舍
+老舍	老舍
+房舍	房舍
+农舍	農舍
+猪舍	豬舍
+舍	捨
+杠	槓
+雇员	僱員
+雇	雇
+刮倒	颳倒
+刮	刮
+狸	狸
+跌交	跌跤
+交	交
+侄媳妇	姪媳婦
+侄女	姪女
+侄孙	姪孫
+侄	侄
+勋	勳
+秋千	鞦韆
+荡秋千	盪鞦韆
+荡	蕩
+秋	秋
+不寒而栗	不寒而慄
+颤栗	顫慄
+战栗	戰慄
+栗	栗
+细嚼慢咽	細嚼慢嚥
+狼吞虎咽	狼吞虎嚥
+咽气	嚥氣
+下咽	下嚥
+咽	咽
+吊民伐罪	弔民伐罪
+形影相吊	形影相弔
+提心吊胆	提心弔膽
+吊丧	弔喪
+吊慰	弔慰
+吊唁	弔唁
+吊	吊
+英寸	英吋
+方腊	方腊
+腊	臘
+乡愿	鄉愿
+愿	願
+古迹	古蹟
+史迹	史蹟
+迹	跡
+净	淨
+侥幸	僥倖
+侥	僥
+幸	幸
+蚝	蠔
+柜柳	柜柳
+柜	櫃
+拉纤	拉縴
+纤夫	縴夫
+纤路	縴路
+纤绳	縴繩
+纤	纖
+厚朴	厚朴
+朴刀	朴刀
+朴硝	朴硝
+朴	樸
+钟灵毓秀	鍾靈毓秀
+一见钟情	一見鍾情
+千钟粟	千鍾粟
+龙钟	龍鍾
+独钟	獨鍾
+汉钟离	漢鍾離
+所钟	所鍾
+钟离	鍾離
+钟爱	鍾愛
+钟馗	鍾馗
+钟山	鍾山
+钟	鐘
+锺	鍾
+沾恩	霑恩
+沾霈	霑霈
+沾濡	霑濡
+沾渥	霑渥
+沾衣	霑衣
+沾醉	霑醉
+著名	著名
+著称	著稱
+著述	著述
+著作	著作
+著书	著書
+著绩	著績
+著录	著錄
+著文	著文
+著有	著有
+著者	著者
+见微知著	見微知著
+信义素著	信義素著
+显著	顯著
+论著	論著
+编著	編著
+炳著	炳著
+昭著	昭著
+大著	大著
+合著	合著
+巨著	巨著
+钜著	鉅著
+较著	較著
+旧著	舊著
+毛著	毛著
+名著	名著
+暴著	暴著
+卓著	卓著
+土著	土著
+新著	新著
+玄著	玄著
+遗著	遺著
+译著	譯著
+原著	原著
+专著	專著
+撰著	撰著
+拙著	拙著
+着	著
+著	著
+扭转乾坤	扭轉乾坤
+旋乾转坤	旋乾轉坤
+朗朗乾坤	朗朗乾坤
+搜罗	蒐羅
+搜集	蒐集
+搜录	蒐錄
+搜	搜
+抽签	抽籤
+签诗	籤詩
+签条	籤條
+签筒	籤筒
+签文	籤文
+签语	籤語
+求签	求籤
+竹签	竹籤
+芸签缥带	芸籤縹帶
+芸	芸
+万签插架	萬籤插架
+万	萬
+解签	解籤
+签谱	籤譜
+中签	中籤
+签	簽
+炼石补天	鍊石補天
+炼而愈精	鍊而愈精
+久炼成钢	久鍊成鋼
+千锤百炼	千錘百鍊
+百炼	百鍊
+炼铝	鍊鋁
+炼铜	鍊銅
+炼句	鍊句
+淬炼	焠鍊
+锻炼	鍛鍊
+锤炼	錘鍊
+锤	錘
+金链	金鍊
+链子	鍊子
+拉链	拉鍊
+手链	手鍊
+铁链	鐵鍊
+项链	項鍊
+炼	煉
+练	練
+链	鏈
+荧光	螢光
+荧幕	螢幕
+荧屏	螢屏
+荧	熒
+萤	螢
+霉	霉
+艳	艷
+证	證
+尝	嘗
+吃	吃
+铺	鋪
+唇	唇
+壳	殼
+遁	遁
+姐	姐
+污	污
+个	個
+哗	嘩
+焊	焊
+馈	饋
+梁	梁
+具	具
+私	私
+鉴	鑒
+湿	濕
+局	局
+钜	鉅
+啰	囉
+袅	裊
+叠	疊
+钳	鉗
+镕	鎔
+沙金	砂金
+沙土	砂土
+相片	像片
+毁	毀
+虱目鱼	虱目魚
+虱	蝨
+赞	贊
+绔	絝
+绉褶	縐摺
+皱褶	皺摺
+折纸	摺紙
+绉	縐
+五岳	五嶽
+东岳	東嶽
+南岳	南嶽
+西岳	西嶽
+北岳	北嶽
+山岳	山嶽
+中岳	中嶽
+岱岳	岱嶽
+奇岩	奇巖
+峭壁巉岩	峭壁巉巖
+岩穴	巖穴
+凿岩成室	鑿巖成室
+凿通岩洞	鑿通巖洞
+掸	撣
+久病不愈	久病不癒
+不药而愈	不藥而癒
+固疾痊愈	固疾痊癒
+疗愈	療癒
+愈复	癒復
+病愈	病癒
+痊愈	痊癒
+治愈	治癒
+棂	櫺
+呼吁	呼籲
+吁求	籲求
+吁请	籲請
+吁防	籲防
+叮当	叮噹
+叮叮当当	叮叮噹噹
+疏浚	疏濬
+铲	鏟
+炭烟	碳煙
+烟熏	煙薰
+烟	煙
+羡	羨
+徭役	繇役
+弥漫	瀰漫
+弥	彌
+谘	諮
+缰	繮
+昵	暱
+瓮	甕
+名噪一时	名譟一時
+鼓噪	鼓譟
+礴	礡
+膻	羶
+蝎	蠍
+棱	稜
+喂	餵
+腌	醃
+排泄	排泄
+泄	洩
+昌言	倡言
+依傍	依徬
+彷	徬
+仿如	彷如
+仿佛	彷彿
+仿	仿
+抚恤	撫卹
+恤养	卹養
+啮	嚙
+敛	斂
+埙	塤
+构	構
+鳌	鰲
+珐琅	琺瑯
+谥	謚
+蓝	藍
+缕	縷
+褴	襤
+褛	褸
+挂碍	罣礙
+挂	掛
+积肴于案	積餚於案
+佳肴	佳餚
+酒肴	酒餚
+肴馔	餚饌
+菜肴	菜餚
+枪	槍
+鳖	鱉
+睾	睪
+镌	鐫
+于	於
+亘	亙
+铝	鋁
+极	極
+锨	鍁
+咏	詠
+琼	瓊
+莼	蒓
+鲞	鮝
+鹚	鷀
+种	種
+妒	妒
+和	和
+傥	儻
+倘	倘
+硷	礆
+鲇	鮎
+㟆	㠏
+㨫	㩜
+䌶	䊷
+䌺	䋙
+䌾	䋻
+䞍	䝼
+䯅	䯀
+䲝	䱽
+鲃	䰾
+鳚	䲁
+丢	丟
+乱	亂
+亚	亞
+卧	臥
+伫	佇
+来	來
+侣	侶
+俣	俁
+伣	俔
+侠	俠
+伥	倀
+俩	倆
+俫	倈
+仓	倉
+们	們
+伦	倫
+伟	偉
+侧	側
+侦	偵
+杰	傑
+伧	傖
+伞	傘
+备	備
+佣	傭
+偬	傯
+传	傳
+伛	傴
+债	債
+伤	傷
+倾	傾
+偻	僂
+仅	僅
+佥	僉
+侨	僑
+仆	僕
+偾	僨
+价	價
+仪	儀
+侬	儂
+亿	億
+侩	儈
+俭	儉
+傧	儐
+俦	儔
+侪	儕
+偿	償
+优	優
+储	儲
+俪	儷
+㑩	儸
+傩	儺
+俨	儼
+丰标不凡	丰標不凡
+丰仪	丰儀
+丰韵	丰韻
+张三丰	張三丰
+丰	豐
+兑	兌
+儿	兒
+兖	兗
+内	內
+两	兩
+册	冊
+幂	冪
+冻	凍
+凛	凜
+凯	凱
+删	刪
+刭	剄
+则	則
+刹	剎
+刬	剗
+刚	剛
+剥	剝
+剐	剮
+剀	剴
+创	創
+剧	劇
+刘	劉
+刽	劊
+刿	劌
+剑	劍
+㓥	劏
+剂	劑
+㔉	劚
+劲	勁
+动	動
+务	務
+胜	勝
+劳	勞
+势	勢
+勚	勩
+劢	勱
+励	勵
+劝	勸
+匀	勻
+匦	匭
+匮	匱
+区	區
+协	協
+却	卻
+厍	厙
+厌	厭
+厉	厲
+厣	厴
+参	參
+叁	叄
+丛	叢
+咤	吒
+吴	吳
+呐	吶
+吕	呂
+呙	咼
+员	員
+呗	唄
+问	問
+哑	啞
+唡	啢
+㖞	喎
+唤	喚
+丧	喪
+乔	喬
+单	單
+哟	喲
+呛	嗆
+啬	嗇
+唝	嗊
+吗	嗎
+呜	嗚
+唢	嗩
+哔	嗶
+喽	嘍
+呕	嘔
+啧	嘖
+唛	嘜
+唠	嘮
+啸	嘯
+叽	嘰
+哓	嘵
+呒	嘸
+啴	嘽
+嘘	噓
+㖊	噚
+咝	噝
+哒	噠
+哝	噥
+哕	噦
+嗳	噯
+哙	噲
+喷	噴
+吨	噸
+咛	嚀
+吓	嚇
+哜	嚌
+噜	嚕
+呖	嚦
+咙	嚨
+亸	嚲
+喾	嚳
+严	嚴
+嘤	嚶
+啭	囀
+嗫	囁
+嚣	囂
+冁	囅
+呓	囈
+嘱	囑
+囱	囪
+囵	圇
+国	國
+围	圍
+园	園
+圆	圓
+图	圖
+埯	垵
+垭	埡
+执	執
+坚	堅
+垩	堊
+垴	堖
+埚	堝
+尧	堯
+报	報
+场	場
+块	塊
+茔	塋
+垲	塏
+埘	塒
+涂	塗
+坞	塢
+尘	塵
+堑	塹
+垫	墊
+坠	墜
+堕	墮
+坟	墳
+垦	墾
+垱	壋
+压	壓
+垒	壘
+圹	壙
+垆	壚
+坏	壞
+垄	壟
+垅	壠
+坜	壢
+坝	壩
+壮	壯
+壶	壺
+壸	壼
+寿	壽
+够	夠
+梦	夢
+夹	夾
+奂	奐
+奥	奧
+奁	奩
+夺	奪
+奋	奮
+姹	奼
+姗	姍
+娱	娛
+娄	婁
+妇	婦
+娅	婭
+娲	媧
+媪	媼
+妈	媽
+妪	嫗
+妩	嫵
+娴	嫻
+婳	嫿
+娆	嬈
+婵	嬋
+娇	嬌
+嫱	嬙
+嫒	嬡
+嬷	嬤
+嫔	嬪
+婴	嬰
+婶	嬸
+娈	孌
+孙	孫
+学	學
+孪	孿
+宫	宮
+寝	寢
+实	實
+宁	寧
+审	審
+写	寫
+宽	寬
+宠	寵
+宝	寶
+将	將
+专	專
+寻	尋
+对	對
+导	導
+尴	尷
+届	屆
+尸	屍
+屃	屓
+屉	屜
+屡	屢
+层	層
+屦	屨
+属	屬
+冈	岡
+岘	峴
+岛	島
+峡	峽
+崃	崍
+岗	崗
+峥	崢
+岽	崬
+岚	嵐
+嵝	嶁
+崭	嶄
+岖	嶇
+嵚	嶔
+崂	嶗
+峤	嶠
+峣	嶢
+峄	嶧
+崄	嶮
+岙	嶴
+嵘	嶸
+岭	嶺
+屿	嶼
+岿	巋
+峦	巒
+巅	巔
+巯	巰
+帅	帥
+师	師
+帐	帳
+带	帶
+帧	幀
+帏	幃
+帼	幗
+帻	幘
+帜	幟
+币	幣
+帮	幫
+帱	幬
+库	庫
+厢	廂
+厩	廄
+厦	廈
+厨	廚
+厮	廝
+庙	廟
+厂	廠
+庑	廡
+废	廢
+广	廣
+廪	廩
+庐	廬
+厅	廳
+弑	弒
+弪	弳
+张	張
+强	強
+弹	彈
+弯	彎
+彦	彥
+径	徑
+从	從
+徕	徠
+彻	徹
+恒	恆
+悦	悅
+悮	悞
+怅	悵
+闷	悶
+恶	惡
+恼	惱
+恽	惲
+恻	惻
+爱	愛
+惬	愜
+怆	愴
+恺	愷
+忾	愾
+态	態
+愠	慍
+惨	慘
+惭	慚
+恸	慟
+惯	慣
+怄	慪
+怂	慫
+虑	慮
+悭	慳
+庆	慶
+惫	憊
+怜	憐
+凭	憑
+愦	憒
+惮	憚
+愤	憤
+悯	憫
+怃	憮
+宪	憲
+忆	憶
+恳	懇
+应	應
+怿	懌
+懔	懍
+怼	懟
+懑	懣
+恹	懨
+惩	懲
+懒	懶
+怀	懷
+悬	懸
+忏	懺
+惧	懼
+慑	懾
+恋	戀
+戆	戇
+戋	戔
+戗	戧
+戬	戩
+战	戰
+戯	戱
+戏	戲
+户	戶
+抛	拋
+捝	挩
+挟	挾
+扪	捫
+扫	掃
+抡	掄
+挜	掗
+挣	掙
+拣	揀
+换	換
+挥	揮
+损	損
+摇	搖
+揾	搵
+抢	搶
+掴	摑
+掼	摜
+搂	摟
+挚	摯
+抠	摳
+抟	摶
+掺	摻
+捞	撈
+挦	撏
+撑	撐
+挠	撓
+㧑	撝
+挢	撟
+拨	撥
+抚	撫
+扑	撲
+揿	撳
+挞	撻
+挝	撾
+捡	撿
+拥	擁
+掳	擄
+择	擇
+击	擊
+挡	擋
+㧟	擓
+担	擔
+据	據
+挤	擠
+拟	擬
+摈	擯
+拧	擰
+搁	擱
+掷	擲
+扩	擴
+撷	擷
+摆	擺
+擞	擻
+撸	擼
+扰	擾
+摅	攄
+撵	攆
+拢	攏
+拦	攔
+撄	攖
+搀	攙
+撺	攛
+携	攜
+摄	攝
+攒	攢
+挛	攣
+摊	攤
+搅	攪
+揽	攬
+败	敗
+叙	敘
+敌	敵
+数	數
+毙	斃
+斓	斕
+斩	斬
+断	斷
+时	時
+晋	晉
+昼	晝
+晕	暈
+晖	暉
+旸	暘
+畅	暢
+暂	暫
+晔	曄
+昙	曇
+晓	曉
+暧	曖
+旷	曠
+昽	曨
+晒	曬
+书	書
+会	會
+东	東
+栅	柵
+杆	桿
+栀	梔
+枧	梘
+条	條
+枭	梟
+棁	梲
+弃	棄
+枨	棖
+枣	棗
+栋	棟
+栈	棧
+栖	棲
+梾	棶
+桠	椏
+杨	楊
+枫	楓
+桢	楨
+业	業
+杩	榪
+荣	榮
+榅	榲
+桤	榿
+梿	槤
+椠	槧
+椁	槨
+桨	槳
+桩	樁
+乐	樂
+枞	樅
+楼	樓
+枢	樞
+样	樣
+树	樹
+桦	樺
+桡	橈
+桥	橋
+椭	橢
+横	橫
+檩	檁
+柽	檉
+档	檔
+桧	檜
+槚	檟
+检	檢
+樯	檣
+梼	檮
+槟	檳
+柠	檸
+槛	檻
+橹	櫓
+榈	櫚
+栉	櫛
+椟	櫝
+橼	櫞
+栎	櫟
+橱	櫥
+槠	櫧
+栌	櫨
+枥	櫪
+橥	櫫
+榇	櫬
+蘖	櫱
+栊	櫳
+榉	櫸
+樱	櫻
+栏	欄
+权	權
+椤	欏
+栾	欒
+榄	欖
+钦	欽
+欧	歐
+欤	歟
+欢	歡
+岁	歲
+归	歸
+殁	歿
+残	殘
+殒	殞
+殇	殤
+㱮	殨
+殚	殫
+殓	殮
+殡	殯
+㱩	殰
+歼	殲
+杀	殺
+殴	毆
+毵	毿
+牦	氂
+毡	氈
+氇	氌
+氢	氫
+氩	氬
+氲	氳
+郁金香	鬱金香
+郁闷	鬱悶
+郁郁	鬱鬱
+阴郁	陰鬱
+沉郁	沈鬱
+苍郁	蒼鬱
+忧郁	憂鬱
+悒郁	悒鬱
+抑郁	抑鬱
+积郁	積鬱
+忧	憂
+沈大铁路	瀋大鐵路
+沈大高速	瀋大高速
+沈大线	瀋大線
+沈吉铁路	瀋吉鐵路
+沈吉高速	瀋吉高速
+沈吉线	瀋吉線
+沈山高速	瀋山高速
+沈山铁路	瀋山鐵路
+沈山线	瀋山線
+沈阳	瀋陽
+沈哈	瀋哈
+京沈	京瀋
+辽沈	遼瀋
+墨渖未干	墨瀋未乾
+渖	瀋
+石沉大海	石沈大海
+鱼沉雁杳	魚沈雁杳
+破釜沉舟	破釜沈舟
+沉沉浮浮	沈沈浮浮
+沉浮	沈浮
+沉默	沈默
+沉重	沈重
+沉思	沈思
+沉淀	沈澱
+沉稳	沈穩
+沉浸	沈浸
+沉闷	沈悶
+沉静	沈靜
+沉醉	沈醉
+沉迷	沈迷
+沉寂	沈寂
+沉入	沈入
+沉沉	沈沈
+沉落	沈落
+沉睡	沈睡
+沉潜	沈潛
+沉沦	沈淪
+沉吟	沈吟
+沉积	沈積
+沉着	沈著
+沉没	沈沒
+低沉	低沈
+消沉	消沈
+深沉	深沈
+浮沉	浮沈
+沉	沈
+决	決
+没	沒
+况	況
+汹	洶
+浃	浹
+泾	涇
+凄	淒
+凉	涼
+泪	淚
+渌	淥
+沦	淪
+渊	淵
+涞	淶
+浅	淺
+涣	渙
+减	減
+涡	渦
+测	測
+浑	渾
+凑	湊
+浈	湞
+汤	湯
+沟	溝
+温	溫
+沧	滄
+灭	滅
+涤	滌
+荥	滎
+沪	滬
+滞	滯
+渗	滲
+浒	滸
+浐	滻
+滚	滾
+满	滿
+渔	漁
+沤	漚
+汉	漢
+涟	漣
+渍	漬
+涨	漲
+溆	漵
+渐	漸
+浆	漿
+颍	潁
+泼	潑
+洁	潔
+潜	潛
+润	潤
+浔	潯
+溃	潰
+滗	潷
+涠	潿
+涩	澀
+浇	澆
+涝	澇
+涧	澗
+渑	澠
+泽	澤
+滪	澦
+泶	澩
+浍	澮
+浊	濁
+浓	濃
+泞	濘
+济	濟
+涛	濤
+滥	濫
+潍	濰
+滨	濱
+溅	濺
+泺	濼
+滤	濾
+滢	瀅
+渎	瀆
+㲿	瀇
+泻	瀉
+浏	瀏
+濒	瀕
+泸	瀘
+沥	瀝
+潇	瀟
+潆	瀠
+潴	瀦
+泷	瀧
+濑	瀨
+潋	瀲
+澜	瀾
+沣	灃
+滠	灄
+洒	灑
+漓	灕
+滩	灘
+灏	灝
+漤	灠
+湾	灣
+滦	灤
+滟	灧
+灾	災
+乌	烏
+烃	烴
+无	無
+炜	煒
+茕	煢
+焕	煥
+烦	煩
+炀	煬
+㶽	煱
+煴	熅
+炝	熗
+热	熱
+颎	熲
+炽	熾
+烨	燁
+灯	燈
+炖	燉
+烧	燒
+烫	燙
+焖	燜
+营	營
+灿	燦
+烛	燭
+烩	燴
+㶶	燶
+烬	燼
+焘	燾
+烁	爍
+炉	爐
+烂	爛
+争	爭
+爷	爺
+尔	爾
+牍	牘
+牵	牽
+荦	犖
+犊	犢
+牺	犧
+状	狀
+狭	狹
+狈	狽
+狰	猙
+犹	猶
+狲	猻
+犸	獁
+狱	獄
+狮	獅
+独	獨
+狯	獪
+猃	獫
+狝	獮
+狞	獰
+㺍	獱
+猎	獵
+犷	獷
+兽	獸
+獭	獺
+献	獻
+猕	獼
+猡	玀
+现	現
+珐	琺
+珲	琿
+玮	瑋
+玚	瑒
+琐	瑣
+瑶	瑤
+莹	瑩
+玛	瑪
+玱	瑲
+琏	璉
+玑	璣
+瑷	璦
+珰	璫
+环	環
+玺	璽
+珑	瓏
+璎	瓔
+瓒	瓚
+瓯	甌
+亩	畝
+毕	畢
+异	異
+畴	疇
+痉	痙
+疴	痾
+痖	瘂
+疯	瘋
+疡	瘍
+痪	瘓
+瘗	瘞
+疮	瘡
+疟	瘧
+瘆	瘮
+疭	瘲
+疗	療
+痨	癆
+痫	癇
+瘅	癉
+疠	癘
+瘪	癟
+痒	癢
+疖	癤
+疬	癧
+癞	癩
+癣	癬
+瘿	癭
+瘾	癮
+痈	癰
+瘫	癱
+癫	癲
+皑	皚
+疱	皰
+皲	皸
+皱	皺
+盗	盜
+盏	盞
+监	監
+盘	盤
+卢	盧
+睁	睜
+睐	睞
+眍	瞘
+䁖	瞜
+瞒	瞞
+瞆	瞶
+睑	瞼
+眬	矓
+瞩	矚
+矫	矯
+硁	硜
+硖	硤
+砗	硨
+砚	硯
+硕	碩
+砀	碭
+砜	碸
+确	確
+码	碼
+硙	磑
+砖	磚
+碜	磣
+碛	磧
+矶	磯
+硗	磽
+础	礎
+碍	礙
+矿	礦
+砺	礪
+砾	礫
+矾	礬
+砻	礱
+禄	祿
+祸	禍
+祯	禎
+祎	禕
+祃	禡
+禅	禪
+礼	禮
+祢	禰
+祷	禱
+秃	禿
+籼	秈
+税	稅
+䅉	稏
+禀	稟
+称	稱
+稣	穌
+积	積
+颖	穎
+秾	穠
+穑	穡
+秽	穢
+稳	穩
+稆	穭
+窝	窩
+洼	窪
+穷	窮
+窑	窯
+窎	窵
+窭	窶
+窥	窺
+窜	竄
+窍	竅
+窦	竇
+窃	竊
+竞	競
+笔	筆
+笋	筍
+笕	筧
+䇲	筴
+筝	箏
+节	節
+范	範
+筑	築
+箧	篋
+筼	篔
+笃	篤
+筛	篩
+筚	篳
+箦	簀
+篓	簍
+箪	簞
+简	簡
+篑	簣
+箫	簫
+筜	簹
+帘	簾
+篮	籃
+筹	籌
+箓	籙
+箨	籜
+籁	籟
+笼	籠
+笾	籩
+簖	籪
+篱	籬
+箩	籮
+粤	粵
+糁	糝
+粪	糞
+粮	糧
+粝	糲
+籴	糴
+粜	糶
+纟	糹
+纠	糾
+纪	紀
+纣	紂
+约	約
+红	紅
+纡	紆
+纥	紇
+纨	紈
+纫	紉
+纹	紋
+纳	納
+纽	紐
+纾	紓
+纯	純
+纰	紕
+纼	紖
+纱	紗
+纮	紘
+纸	紙
+级	級
+纷	紛
+纭	紜
+纴	紝
+纺	紡
+䌷	紬
+细	細
+绂	紱
+绁	紲
+绅	紳
+纻	紵
+绍	紹
+绀	紺
+绋	紼
+绐	紿
+绌	絀
+终	終
+组	組
+䌹	絅
+绊	絆
+绗	絎
+结	結
+绞	絞
+络	絡
+绚	絢
+给	給
+绒	絨
+绖	絰
+统	統
+丝	絲
+绛	絳
+绢	絹
+绑	綁
+绡	綃
+绠	綆
+绨	綈
+绤	綌
+绥	綏
+䌼	綐
+经	經
+综	綜
+缍	綞
+绸	綢
+绻	綣
+绶	綬
+维	維
+绹	綯
+绾	綰
+纲	綱
+网	網
+缀	綴
+纶	綸
+绺	綹
+绮	綺
+绽	綻
+绰	綽
+绫	綾
+绵	綿
+绲	緄
+缁	緇
+紧	緊
+绯	緋
+绪	緒
+绬	緓
+缃	緗
+缄	緘
+缂	緙
+缉	緝
+缎	緞
+缔	締
+缗	緡
+缘	緣
+缌	緦
+编	編
+缓	緩
+缅	緬
+纬	緯
+缑	緱
+缈	緲
+缏	緶
+缇	緹
+萦	縈
+缙	縉
+缢	縊
+缒	縋
+缣	縑
+缊	縕
+缞	縗
+缚	縛
+缜	縝
+缟	縞
+缛	縟
+县	縣
+缝	縫
+缡	縭
+缩	縮
+纵	縱
+缧	縲
+䌸	縳
+缦	縵
+絷	縶
+缥	縹
+总	總
+绩	績
+缫	繅
+缪	繆
+缯	繒
+织	織
+缮	繕
+缭	繚
+绕	繞
+缋	繢
+绳	繩
+绘	繪
+茧	繭
+缳	繯
+缲	繰
+缴	繳
+䍁	繸
+绎	繹
+继	繼
+缤	繽
+缱	繾
+䍀	繿
+缬	纈
+纩	纊
+续	續
+缠	纏
+缨	纓
+缵	纘
+缆	纜
+罂	罌
+罚	罰
+罢	罷
+罗	羅
+罴	羆
+羁	羈
+芈	羋
+羟	羥
+义	義
+习	習
+翘	翹
+耧	耬
+耢	耮
+圣	聖
+闻	聞
+联	聯
+聪	聰
+声	聲
+耸	聳
+聩	聵
+聂	聶
+职	職
+聍	聹
+听	聽
+聋	聾
+肃	肅
+胁	脅
+脉	脈
+胫	脛
+脱	脫
+胀	脹
+肾	腎
+胨	腖
+脶	腡
+脑	腦
+肿	腫
+脚	腳
+肠	腸
+腽	膃
+肤	膚
+胶	膠
+腻	膩
+胆	膽
+脍	膾
+脓	膿
+脸	臉
+脐	臍
+膑	臏
+胪	臚
+脔	臠
+臜	臢
+临	臨
+与	與
+兴	興
+举	舉
+旧	舊
+舱	艙
+舣	艤
+舰	艦
+舻	艫
+艰	艱
+刍	芻
+苎	苧
+兹	茲
+荆	荊
+庄	莊
+茎	莖
+荚	莢
+苋	莧
+华	華
+苌	萇
+莱	萊
+莴	萵
+荭	葒
+荮	葤
+苇	葦
+荤	葷
+莳	蒔
+莅	蒞
+苍	蒼
+荪	蓀
+盖	蓋
+莲	蓮
+苁	蓯
+荜	蓽
+蒌	蔞
+蒋	蔣
+葱	蔥
+茑	蔦
+荫	蔭
+荨	蕁
+蒇	蕆
+荞	蕎
+荬	蕒
+莸	蕕
+荛	蕘
+蒉	蕢
+芜	蕪
+萧	蕭
+蓣	蕷
+蕰	薀
+荟	薈
+蓟	薊
+芗	薌
+蔷	薔
+荙	薘
+莶	薟
+荐	薦
+萨	薩
+䓕	薳
+荠	薺
+荩	藎
+艺	藝
+薮	藪
+苈	藶
+蔼	藹
+蔺	藺
+蕲	蘄
+芦	蘆
+苏	蘇
+藓	蘚
+蔹	蘞
+茏	蘢
+兰	蘭
+蓠	蘺
+萝	蘿
+蔂	虆
+处	處
+虚	虛
+虏	虜
+号	號
+亏	虧
+虬	虯
+蛱	蛺
+蜕	蛻
+蚬	蜆
+蚀	蝕
+猬	蝟
+虾	蝦
+蜗	蝸
+蛳	螄
+蚂	螞
+䗖	螮
+蝼	螻
+螀	螿
+蛰	蟄
+蝈	蟈
+螨	蟎
+虮	蟣
+蝉	蟬
+蛲	蟯
+虫	蟲
+蛏	蟶
+蚁	蟻
+蝇	蠅
+虿	蠆
+蛴	蠐
+蝾	蠑
+蛎	蠣
+蟏	蠨
+蛊	蠱
+蚕	蠶
+蛮	蠻
+术	術
+卫	衛
+衮	袞
+补	補
+装	裝
+裈	褌
+袆	褘
+裤	褲
+裢	褳
+亵	褻
+裥	襇
+袯	襏
+袄	襖
+裣	襝
+裆	襠
+袜	襪
+䙓	襬
+衬	襯
+袭	襲
+见	見
+觃	覎
+规	規
+觅	覓
+视	視
+觇	覘
+觋	覡
+觍	覥
+觎	覦
+亲	親
+觊	覬
+觏	覯
+觐	覲
+觑	覷
+觉	覺
+览	覽
+觌	覿
+观	觀
+觞	觴
+觯	觶
+触	觸
+讠	訁
+订	訂
+讣	訃
+计	計
+讯	訊
+讧	訌
+讨	討
+讦	訐
+讱	訒
+训	訓
+讪	訕
+讫	訖
+记	記
+讹	訛
+讶	訝
+讼	訟
+䜣	訢
+诀	訣
+讷	訥
+讻	訩
+访	訪
+设	設
+许	許
+诉	訴
+诃	訶
+诊	診
+诂	詁
+诋	詆
+讵	詎
+诈	詐
+诒	詒
+诏	詔
+评	評
+诐	詖
+诇	詗
+诎	詘
+诅	詛
+诩	詡
+询	詢
+诣	詣
+试	試
+诗	詩
+诧	詫
+诟	詬
+诡	詭
+诠	詮
+诘	詰
+话	話
+该	該
+详	詳
+诜	詵
+诙	詼
+诖	詿
+诔	誄
+诛	誅
+诓	誆
+夸	誇
+认	認
+诳	誑
+诶	誒
+诞	誕
+诱	誘
+诮	誚
+语	語
+诚	誠
+诫	誡
+诬	誣
+误	誤
+诰	誥
+诵	誦
+诲	誨
+谁	誰
+课	課
+谇	誶
+诽	誹
+谊	誼
+訚	誾
+调	調
+谄	諂
+谆	諄
+谈	談
+诿	諉
+请	請
+诤	諍
+诹	諏
+诼	諑
+谅	諒
+论	論
+谂	諗
+谀	諛
+谍	諜
+谞	諝
+谝	諞
+诨	諢
+谔	諤
+谛	諦
+谐	諧
+谏	諫
+谕	諭
+讳	諱
+谙	諳
+谌	諶
+讽	諷
+诸	諸
+谚	諺
+谖	諼
+诺	諾
+谋	謀
+谒	謁
+谓	謂
+誊	謄
+诌	謅
+谎	謊
+谜	謎
+谧	謐
+谑	謔
+谡	謖
+谤	謗
+谦	謙
+讲	講
+谢	謝
+谟	謨
+谪	謫
+谬	謬
+讴	謳
+谨	謹
+谩	謾
+䜧	譅
+谲	譎
+讥	譏
+谮	譖
+识	識
+谯	譙
+谭	譚
+谱	譜
+谵	譫
+译	譯
+议	議
+谴	譴
+护	護
+诪	譸
+誉	譽
+读	讀
+变	變
+雠	讎
+谗	讒
+让	讓
+谰	讕
+谶	讖
+谠	讜
+谳	讞
+岂	豈
+猪	豬
+豮	豶
+猫	貓
+䝙	貙
+贝	貝
+贞	貞
+贠	貟
+负	負
+财	財
+贡	貢
+贫	貧
+货	貨
+贩	販
+贪	貪
+贯	貫
+责	責
+贮	貯
+贳	貰
+赀	貲
+贰	貳
+贵	貴
+贬	貶
+买	買
+贷	貸
+贶	貺
+费	費
+贴	貼
+贻	貽
+贸	貿
+贺	賀
+贲	賁
+赂	賂
+赁	賃
+贿	賄
+赅	賅
+资	資
+贾	賈
+贼	賊
+赈	賑
+赊	賒
+宾	賓
+赇	賕
+赒	賙
+赉	賚
+赐	賜
+赏	賞
+赔	賠
+赓	賡
+贤	賢
+卖	賣
+贱	賤
+赋	賦
+赕	賧
+质	質
+账	賬
+赌	賭
+䞐	賰
+赖	賴
+赗	賵
+赚	賺
+赙	賻
+购	購
+赛	賽
+赜	賾
+贽	贄
+赘	贅
+赟	贇
+赠	贈
+赡	贍
+赢	贏
+赆	贐
+赑	贔
+赎	贖
+赣	贛
+赪	赬
+赶	趕
+赵	趙
+趋	趨
+趱	趲
+践	踐
+踊	踴
+跄	蹌
+跸	蹕
+蹒	蹣
+踪	蹤
+跷	蹺
+跶	躂
+趸	躉
+踌	躊
+跻	躋
+跃	躍
+踯	躑
+跞	躒
+踬	躓
+蹰	躕
+跹	躚
+蹑	躡
+蹿	躥
+躜	躦
+躏	躪
+躯	軀
+车	車
+轧	軋
+轨	軌
+军	軍
+轪	軑
+轩	軒
+轫	軔
+轭	軛
+软	軟
+轷	軤
+轸	軫
+轱	軲
+轴	軸
+轵	軹
+轺	軺
+轲	軻
+轶	軼
+轼	軾
+较	較
+辂	輅
+辁	輇
+辀	輈
+载	載
+轾	輊
+辄	輒
+挽	輓
+辅	輔
+轻	輕
+辆	輛
+辎	輜
+辉	輝
+辋	輞
+辍	輟
+辊	輥
+辇	輦
+辈	輩
+轮	輪
+辌	輬
+辑	輯
+辏	輳
+输	輸
+辐	輻
+辗	輾
+舆	輿
+辒	轀
+毂	轂
+辖	轄
+辕	轅
+辘	轆
+转	轉
+辙	轍
+轿	轎
+辚	轔
+轰	轟
+辔	轡
+轹	轢
+轳	轤
+办	辦
+辫	辮
+辩	辯
+农	農
+迳	逕
+这	這
+连	連
+进	進
+运	運
+过	過
+达	達
+违	違
+遥	遙
+逊	遜
+递	遞
+远	遠
+适	適
+迟	遲
+迁	遷
+选	選
+遗	遺
+辽	遼
+迈	邁
+还	還
+迩	邇
+边	邊
+逻	邏
+逦	邐
+郏	郟
+邮	郵
+郓	鄆
+乡	鄉
+邹	鄒
+邬	鄔
+郧	鄖
+邓	鄧
+郑	鄭
+邻	鄰
+郸	鄲
+邺	鄴
+郐	鄶
+邝	鄺
+酂	酇
+郦	酈
+医	醫
+酱	醬
+酦	醱
+酿	釀
+衅	釁
+酾	釃
+酽	釅
+释	釋
+厘	釐
+钅	釒
+钆	釓
+钇	釔
+钌	釕
+钊	釗
+钉	釘
+钋	釙
+针	針
+钓	釣
+钐	釤
+钏	釧
+钒	釩
+钗	釵
+钍	釷
+钕	釹
+钎	釺
+钯	鈀
+钫	鈁
+钘	鈃
+钭	鈄
+钚	鈈
+钠	鈉
+钝	鈍
+钤	鈐
+钣	鈑
+钑	鈒
+钞	鈔
+钮	鈕
+钧	鈞
+钙	鈣
+钬	鈥
+钛	鈦
+钪	鈧
+铌	鈮
+铈	鈰
+钶	鈳
+铃	鈴
+钴	鈷
+钹	鈸
+铍	鈹
+钰	鈺
+钸	鈽
+铀	鈾
+钿	鈿
+钾	鉀
+铊	鉈
+铉	鉉
+铇	鉋
+铋	鉍
+铂	鉑
+钷	鉕
+铆	鉚
+铅	鉛
+钺	鉞
+钲	鉦
+钼	鉬
+钽	鉭
+铏	鉶
+铰	鉸
+铒	鉺
+铬	鉻
+铪	鉿
+银	銀
+铳	銃
+铜	銅
+铚	銍
+铣	銑
+铨	銓
+铢	銖
+铭	銘
+铫	銚
+铦	銛
+衔	銜
+铑	銠
+铷	銣
+铱	銥
+铟	銦
+铵	銨
+铥	銩
+铕	銪
+铯	銫
+铐	銬
+铞	銱
+销	銷
+锑	銻
+锉	銼
+锒	鋃
+锌	鋅
+钡	鋇
+铤	鋌
+铗	鋏
+锋	鋒
+铻	鋙
+锊	鋝
+锓	鋟
+铘	鋣
+锄	鋤
+锃	鋥
+锔	鋦
+锇	鋨
+铓	鋩
+铖	鋮
+锆	鋯
+锂	鋰
+铽	鋱
+锍	鋶
+锯	鋸
+钢	鋼
+锞	錁
+锖	錆
+锫	錇
+锩	錈
+铔	錏
+锥	錐
+锕	錒
+锟	錕
+锱	錙
+铮	錚
+锛	錛
+锬	錟
+锭	錠
+锜	錡
+钱	錢
+锦	錦
+锚	錨
+锠	錩
+锡	錫
+锢	錮
+错	錯
+锰	錳
+铼	錸
+锝	鍀
+锪	鍃
+钔	鍆
+锴	鍇
+锳	鍈
+锅	鍋
+镀	鍍
+锷	鍔
+铡	鍘
+钖	鍚
+锻	鍛
+锽	鍠
+锸	鍤
+锲	鍥
+锘	鍩
+锹	鍬
+锾	鍰
+键	鍵
+锶	鍶
+锗	鍺
+镁	鎂
+锿	鎄
+镅	鎇
+镑	鎊
+锁	鎖
+镉	鎘
+镈	鎛
+镃	鎡
+钨	鎢
+蓥	鎣
+镏	鎦
+铠	鎧
+铩	鎩
+锼	鎪
+镐	鎬
+镇	鎮
+镒	鎰
+镋	鎲
+镍	鎳
+镓	鎵
+镎	鎿
+镞	鏃
+镟	鏇
+镆	鏌
+镙	鏍
+镠	鏐
+镝	鏑
+铿	鏗
+锵	鏘
+镗	鏜
+镘	鏝
+镛	鏞
+镜	鏡
+镖	鏢
+镂	鏤
+錾	鏨
+镚	鏰
+铧	鏵
+镤	鏷
+镪	鏹
+铙	鐃
+铴	鐋
+镣	鐐
+铹	鐒
+镦	鐓
+镡	鐔
+镫	鐙
+镨	鐠
+锎	鐦
+锏	鐧
+镄	鐨
+镰	鐮
+镯	鐲
+镭	鐳
+铁	鐵
+镮	鐶
+铎	鐸
+铛	鐺
+镱	鐿
+铸	鑄
+镬	鑊
+镔	鑌
+镲	鑔
+锧	鑕
+镴	鑞
+铄	鑠
+镳	鑣
+镥	鑥
+镧	鑭
+钥	鑰
+镵	鑱
+镶	鑲
+镊	鑷
+镩	鑹
+锣	鑼
+钻	鑽
+銮	鑾
+凿	鑿
+长	長
+门	門
+闩	閂
+闪	閃
+闫	閆
+闬	閈
+闭	閉
+开	開
+闶	閌
+闳	閎
+闰	閏
+间	間
+闵	閔
+闸	閘
+阂	閡
+阁	閣
+阀	閥
+闺	閨
+闽	閩
+阃	閫
+阆	閬
+闾	閭
+阊	閶
+阉	閹
+阎	閻
+阏	閼
+阍	閽
+阈	閾
+阌	閿
+阒	闃
+闱	闈
+阔	闊
+阕	闋
+阑	闌
+阇	闍
+阗	闐
+阘	闒
+闿	闓
+阖	闔
+阙	闕
+闯	闖
+阚	闞
+阓	闠
+阐	闡
+阛	闤
+闼	闥
+坂	阪
+陉	陘
+陕	陝
+阵	陣
+阴	陰
+陈	陳
+陆	陸
+阳	陽
+陧	隉
+队	隊
+阶	階
+陨	隕
+际	際
+随	隨
+险	險
+隐	隱
+陇	隴
+隶	隸
+隽	雋
+虽	雖
+双	雙
+雏	雛
+杂	雜
+离	離
+难	難
+电	電
+霡	霢
+雾	霧
+霁	霽
+雳	靂
+霭	靄
+灵	靈
+靓	靚
+静	靜
+靥	靨
+鼗	鞀
+巩	鞏
+鞒	鞽
+鞑	韃
+鞯	韉
+韦	韋
+韧	韌
+韨	韍
+韩	韓
+韪	韙
+韬	韜
+韫	韞
+韵	韻
+响	響
+页	頁
+顶	頂
+顷	頃
+项	項
+顺	順
+顸	頇
+顼	頊
+颂	頌
+颀	頎
+颃	頏
+预	預
+顽	頑
+颁	頒
+顿	頓
+颇	頗
+领	領
+颌	頜
+颉	頡
+颐	頤
+颏	頦
+头	頭
+颒	頮
+颊	頰
+颋	頲
+颕	頴
+颔	頷
+颈	頸
+频	頻
+颗	顆
+题	題
+额	額
+颚	顎
+颙	顒
+颛	顓
+颡	顙
+颠	顛
+类	類
+颟	顢
+颢	顥
+顾	顧
+颤	顫
+颥	顬
+显	顯
+颦	顰
+颅	顱
+颞	顳
+颧	顴
+风	風
+飐	颭
+飑	颮
+飒	颯
+飓	颶
+飔	颸
+飖	颻
+飕	颼
+飗	飀
+飘	飄
+飙	飆
+飚	飈
+飞	飛
+饣	飠
+饤	飣
+饦	飥
+饨	飩
+饪	飪
+饫	飫
+饬	飭
+饭	飯
+饮	飲
+饴	飴
+饲	飼
+饱	飽
+饰	飾
+饳	飿
+饺	餃
+饸	餄
+饼	餅
+饷	餉
+养	養
+饵	餌
+饹	餎
+饻	餏
+饽	餑
+馁	餒
+饿	餓
+馂	餕
+饾	餖
+馄	餛
+馃	餜
+饯	餞
+馅	餡
+馆	館
+糇	餱
+饧	餳
+馉	餶
+馇	餷
+馎	餺
+饩	餼
+馏	餾
+馊	餿
+馌	饁
+馍	饃
+馒	饅
+馐	饈
+馑	饉
+馓	饊
+馔	饌
+饶	饒
+飨	饗
+餍	饜
+馋	饞
+馕	饢
+马	馬
+驭	馭
+冯	馮
+驮	馱
+驰	馳
+驯	馴
+驲	馹
+驳	駁
+驻	駐
+驽	駑
+驹	駒
+驵	駔
+驾	駕
+骀	駘
+驸	駙
+驶	駛
+驼	駝
+驷	駟
+骈	駢
+骇	駭
+骃	駰
+骆	駱
+骎	駸
+骏	駿
+骋	騁
+骍	騂
+骓	騅
+骔	騌
+骒	騍
+骑	騎
+骐	騏
+骛	騖
+骗	騙
+骙	騤
+䯄	騧
+骞	騫
+骘	騭
+骝	騮
+腾	騰
+驺	騶
+骚	騷
+骟	騸
+骡	騾
+蓦	驀
+骜	驁
+骖	驂
+骠	驃
+骢	驄
+驱	驅
+骅	驊
+骕	驌
+骁	驍
+骣	驏
+骄	驕
+验	驗
+惊	驚
+驿	驛
+骤	驟
+驴	驢
+骧	驤
+骥	驥
+骦	驦
+骊	驪
+骉	驫
+肮	骯
+髅	髏
+体	體
+髌	髕
+髋	髖
+鬓	鬢
+闹	鬧
+阋	鬩
+阄	鬮
+魉	魎
+魇	魘
+鱼	魚
+鱽	魛
+鱾	魢
+鲀	魨
+鲁	魯
+鲂	魴
+鱿	魷
+鲄	魺
+鲅	鮁
+鲆	鮃
+鲌	鮊
+鲉	鮋
+鲏	鮍
+鲐	鮐
+鲍	鮑
+鲋	鮒
+鲊	鮓
+鲒	鮚
+鲘	鮜
+鲕	鮞
+鲖	鮦
+鲔	鮪
+鲛	鮫
+鲑	鮭
+鲜	鮮
+鲓	鮳
+鲪	鮶
+鲝	鮺
+鲧	鯀
+鲠	鯁
+鲩	鯇
+鲤	鯉
+鲨	鯊
+鲬	鯒
+鲻	鯔
+鲯	鯕
+鲭	鯖
+鲷	鯛
+鲴	鯝
+鲱	鯡
+鲵	鯢
+鲲	鯤
+鲳	鯧
+鲸	鯨
+鲮	鯪
+鲰	鯫
+鲶	鯰
+鲺	鯴
+鳀	鯷
+鲫	鯽
+鳊	鯿
+鳈	鰁
+鲗	鰂
+鳂	鰃
+鲽	鰈
+鳇	鰉
+鳅	鰍
+鲾	鰏
+鳆	鰒
+鳃	鰓
+鳒	鰜
+鳑	鰟
+鳋	鰠
+鲥	鰣
+鳏	鰥
+鳎	鰨
+鳐	鰩
+鳍	鰭
+鳁	鰮
+鲢	鰱
+鳓	鰳
+鳘	鰵
+鲦	鰷
+鲣	鰹
+鲹	鰺
+鳗	鰻
+鳛	鰼
+鳔	鰾
+鳉	鱂
+鳙	鱅
+鳕	鱈
+鳟	鱒
+鳝	鱔
+鳜	鱖
+鳞	鱗
+鲟	鱘
+鲼	鱝
+鲎	鱟
+鲙	鱠
+鳣	鱣
+鳡	鱤
+鳢	鱧
+鲿	鱨
+鲚	鱭
+鳠	鱯
+鲈	鱸
+鲡	鱺
+鸟	鳥
+鸠	鳩
+鸤	鳲
+凤	鳳
+鸣	鳴
+鸢	鳶
+䴓	鳾
+鸩	鴆
+鸨	鴇
+鸦	鴉
+鸰	鴒
+鸵	鴕
+鸳	鴛
+鸲	鴝
+鸮	鴞
+鸱	鴟
+鸪	鴣
+鸯	鴦
+鸭	鴨
+鸸	鴯
+鸹	鴰
+鸻	鴴
+䴕	鴷
+鸿	鴻
+鸽	鴿
+䴔	鵁
+鸺	鵂
+鸼	鵃
+鹀	鵐
+鹃	鵑
+鹆	鵒
+鹁	鵓
+鹈	鵜
+鹅	鵝
+鹄	鵠
+鹉	鵡
+鹌	鵪
+鹏	鵬
+鹐	鵮
+鹎	鵯
+鹊	鵲
+鹓	鵷
+鹍	鵾
+䴖	鶄
+鸫	鶇
+鹑	鶉
+鹒	鶊
+鹋	鶓
+鹙	鶖
+鹕	鶘
+鹗	鶚
+鹖	鶡
+鹛	鶥
+鹜	鶩
+䴗	鶪
+鸧	鶬
+莺	鶯
+鹟	鶲
+鹤	鶴
+鹠	鶹
+鹡	鶺
+鹘	鶻
+鹣	鶼
+鹢	鷁
+鹞	鷂
+䴘	鷈
+鹝	鷊
+鹧	鷓
+鹥	鷖
+鸥	鷗
+鸷	鷙
+鹨	鷚
+鸶	鷥
+鹪	鷦
+鹔	鷫
+鹩	鷯
+鹫	鷲
+鹇	鷳
+鹬	鷸
+鹰	鷹
+鹭	鷺
+鸴	鷽
+䴙	鷿
+㶉	鸂
+鹯	鸇
+鹱	鸌
+鹲	鸏
+鸬	鸕
+鹴	鸘
+鹦	鸚
+鹳	鸛
+鹂	鸝
+鸾	鸞
+鹾	鹺
+碱	鹼
+盐	鹽
+丽	麗
+麦	麥
+麸	麩
+黄	黃
+黉	黌
+点	點
+党	黨
+黪	黲
+黡	黶
+黩	黷
+黾	黽
+鼋	黿
+鼍	鼉
+鼹	鼴
+齐	齊
+斋	齋
+齑	齏
+齿	齒
+龀	齔
+龁	齕
+龂	齗
+龅	齙
+龇	齜
+龃	齟
+龆	齠
+龄	齡
+龈	齦
+龊	齪
+龉	齬
+龋	齲
+腭	齶
+龌	齷
+龙	龍
+厐	龎
+庞	龐
+龚	龔
+龛	龕
+龟	龜
+“	「
+”	」
+`
+
+const chineseT2STable = `
+于飛	于飞
+于歸	于归
+于思	于思
+單于	单于
+鮮于	鲜于
+薑片	姜片
+薑末	姜末
+生薑	生姜
+鬼子薑	鬼子姜
+姜	姜
+薑	姜
+赤皮崙	赤皮仑
+金崙溪	金仑溪
+下崙	下仑
+中崙	中仑
+崙頂	仑顶
+崑山	昆山
+崑崙	昆仑
+崑曲	昆曲
+崑	昆
+崙	仑
+侖	仑
+曲	曲
+麯	曲
+茶餘飯後	茶余饭后
+餘怒未消	余怒未消
+餘音繞梁	余音绕梁
+餘勇可賈	余勇可贾
+餘波	余波
+餘地	余地
+餘額	余额
+餘角	余角
+餘燼	余烬
+餘款	余款
+餘量	余量
+餘糧	余粮
+餘年	余年
+餘力	余力
+餘生	余生
+餘興	余兴
+餘切	余切
+餘慶	余庆
+餘裕	余裕
+餘杭	余杭
+餘孽	余孽
+餘音	余音
+餘錢	余钱
+餘震	余震
+結餘	结余
+多餘	多余
+有餘	有余
+殘餘	残余
+剩餘	剩余
+其餘	其余
+業餘	业余
+盈餘	盈余
+余	余
+餘	余
+甚麼	什么
+麼	么
+麽	么
+么	么
+複數	复数
+複分數	复分数
+複雜	复杂
+複製	复制
+復	复
+複	复
+瞭然	了然
+瞭解	了解
+瞭望	了望
+明瞭	明了
+了	了
+瞭	了
+解鈴繫鈴	解铃系铃
+繫詞	系词
+繫辭	系辞
+繫念	系念
+關係	关系
+聯繫	联系
+繫於	系于
+維繫	维系
+連繫	连系
+系	系
+係	系
+繫	系
+糊裡糊塗	糊里糊涂
+稀裡糊塗	稀里糊涂
+蒙在鼓裡	蒙在鼓里
+怪裡怪氣	怪里怪气
+傻裡傻氣	傻里傻气
+俗裡俗氣	俗里俗气
+嗲裡嗲氣	嗲里嗲气
+女裡女氣	女里女气
+妖裡妖氣	妖里妖气
+嬌裡嬌氣	娇里娇气
+洋裡洋氣	洋里洋气
+寶裡寶氣	宝里宝气
+土裡土氣	土里土气
+稀裡嘩啦	稀里哗啦
+由表及裡	由表及里
+百裡挑一	百里挑一
+白裡透紅	白里透红
+私下裡	私下里
+骨子裡	骨子里
+窩裡鬥	窝里斗
+忙裡	忙里
+聞裡	闻里
+死裡	死里
+海裡	海里
+浪裡	浪里
+山裡	山里
+地裡	地里
+花裡	花里
+田裡	田里
+溝裡	沟里
+河裡	河里
+江裡	江里
+沙裡	沙里
+雪裡	雪里
+風裡	风里
+雨裡	雨里
+霧裡	雾里
+夜裡	夜里
+省裡	省里
+鎮裡	镇里
+城裡	城里
+市裡	市里
+村裡	村里
+站裡	站里
+段裡	段里
+廠裡	厂里
+店裡	店里
+館裡	馆里
+部裡	部里
+隊裡	队里
+班裡	班里
+關裡	关里
+門裡	门里
+家裡	家里
+屋裡	屋里
+房裡	房里
+連裡	连里
+院裡	院里
+行裡	行里
+園裡	园里
+苑裡	苑里
+明裡	明里
+暗裡	暗里
+字裡	字里
+表裡	表里
+內裡	內里
+手裡	手里
+眼裡	眼里
+嘴裡	嘴里
+口裡	口里
+心裡	心里
+頭裡	头里
+懷裡	怀里
+肚裡	肚里
+這裡	这里
+那裡	那里
+哪裡	哪里
+入裡	入里
+裡裡外外	里里外外
+裡應外合	里应外合
+裡通外國	里通外国
+裡襯	里衬
+裡子	里子
+裡海	里海
+裡手	里手
+裡快	里快
+裡面	里面
+裡層	里层
+裡間	里间
+裡屋	里屋
+裡頭	里头
+裡邊	里边
+裡外	里外
+裡	里
+裏	里
+幹甚麼	干什么
+幹部	干部
+幹才	干才
+幹道	干道
+幹勁	干劲
+幹練	干练
+幹嗎	干吗
+幹事	干事
+幹線	干线
+包幹	包干
+才幹	才干
+高幹	高干
+骨幹	骨干
+苦幹	苦干
+腦幹	脑干
+能幹	能干
+強幹	强干
+實幹	实干
+樹幹	树干
+單幹	调干
+枝幹	枝干
+主幹	主干
+干貝	干贝
+干犯	干犯
+干戈	干戈
+干涉	干涉
+干支	干支
+干連	干连
+干擾	干扰
+干預	干预
+干系	干系
+若干	若干
+天干	天干
+無干	无干
+相干	相干
+乾麵	干面
+乾坤	乾坤
+乾	干
+幹	干
+干	干
+劃時代	划时代
+劃分	划分
+畫分	划分
+劃開	划开
+畫開	划开
+劃一	划一
+畫一	划一
+劃界	划界
+畫界	划界
+劃策	划策
+畫策	划策
+劃歸	划归
+畫歸	划归
+劃清	划清
+畫清	划清
+規劃	规划
+規畫	规划
+策劃	策划
+策畫	策划
+籌劃	筹划
+籌畫	筹划
+計劃	计划
+計畫	计划
+比劃	比划
+比畫	比划
+謀劃	谋划
+謀畫	谋划
+區劃	区划
+區畫	区划
+板畫	版画
+刻劃	刻画
+划	划
+劃	划
+畫	画
+斗量	斗量
+斗胆	斗胆
+斗室	斗室
+斗城	斗城
+斗拱	斗拱
+斗子	斗子
+阿斗	阿斗
+北斗	北斗
+漏斗	漏斗
+熨斗	熨斗
+南斗	南斗
+墨斗	墨斗
+市斗	市斗
+泰斗	泰斗
+星斗	星斗
+鬥	斗
+斗	斗
+闘	斗
+鬭	斗
+週末	周末
+週刊	周刊
+週期	周期
+週歲	周岁
+週年	周年
+周遊	周游
+週轉	周转
+本週	本周
+周	周
+週	周
+松毬	松球
+毬花	球花
+毬果	球果
+鬆動	松动
+鬆緊	松紧
+鬆散	松散
+鬆手	松手
+鬆軟	松软
+鬆綁	松绑
+鬆弛	松弛
+酥鬆	疏松
+放鬆	放松
+輕鬆	轻松
+肉鬆	肉松
+松	松
+鬆	松
+果	果
+菓	果
+老闆	老板
+板	板
+闆	板
+麵條	面条
+麵粉	面粉
+麵包	面包
+麵筋	面筋
+麵食	面食
+炸醬麵	炸酱面
+擔擔麵	担担面
+刀削麵	刀削面
+空心麵	空心面
+陽春麵	阳春面
+甜麵醬	甜面酱
+炒麵	炒面
+擀麵	擀面
+拉麵	拉面
+涼麵	凉面
+湯麵	汤面
+壽麵	寿面
+鹼麵	碱面
+發麵	发面
+白麵	白面
+面	面
+麵	面
+防禦	防御
+禦敵	御敌
+禦寒	御寒
+御	御
+禦	御
+靦腆	腼腆
+靦	腼
+宮商角徵羽	宫商角徵羽
+征伐	征伐
+征服	征服
+征途	征途
+征討	征讨
+長征	长征
+出征	出征
+親征	亲征
+徵	征
+征	征
+颱風	台风
+寫字檯	写字台
+台	台
+颱	台
+臺	台
+檯	台
+衚衕	胡同
+鬍子	胡子
+鬍鬚	胡须
+胡	胡
+鬍	胡
+衚	胡
+鬚根	须根
+鬚鯨	须鲸
+鬚眉	须眉
+龍鬚	龙须
+觸鬚	触须
+須	须
+鬚	须
+形單影隻	形单影只
+祇賀新禧	只贺新禧
+祇請政安	只请政安
+隻身	只身
+隻眼	只眼
+船隻	船只
+艦隻	舰只
+祇管	只管
+祇好	只好
+祇要	只要
+祇有	只有
+祇得	只得
+祇	祇
+只	只
+隻	只
+併發	并发
+併攏	并拢
+併入	并入
+併吞	并吞
+併力	并力
+合併	合并
+吞併	吞并
+並	并
+併	并
+當	当
+噹	当
+藥	药
+葯	药
+佈道	布道
+佈景	布景
+佈局	布局
+佈雷	布雷
+佈施	布施
+佈置	布置
+布穀	布谷
+發佈	发布
+公佈	公布
+宣佈	宣布
+布	布
+佈	布
+開天闢地	开天辟地
+開闢	开辟
+闢邪	辟邪
+辟	辟
+闢	辟
+人言藉藉	人言藉藉
+藉口	借口
+藉故	借故
+藉使	借使
+憑藉	凭藉
+駘藉	骀藉
+慰藉	慰借
+狼藉	狼藉
+藴藉	蕴藉
+枕藉	枕藉
+借	借
+藉	借
+儘管	尽管
+盡	尽
+儘	尽
+叶韻	叶韵
+葉	叶
+叶	叶
+夥計	伙计
+夥伴	伙伴
+伙	伙
+夥	伙
+傢具	家具
+傢伙	家伙
+家	家
+傢	家
+姦夫	奸夫
+姦婦	奸妇
+姦情	奸情
+姦污	奸污
+姦淫	奸淫
+雞姦	鸡奸
+輪姦	轮奸
+強姦	强奸
+通姦	通奸
+誘姦	诱奸
+奸	奸
+姦	奸
+曆書	历书
+曆法	历法
+公曆	公历
+舊曆	旧历
+黃曆	黄历
+日曆	日历
+西曆	西历
+夏曆	夏历
+新曆	新历
+陽曆	阳历
+陰曆	阴历
+月曆	月历
+遊歷	游历
+萬年曆	万年历
+萬曆	万历
+歷	历
+曆	历
+万俟	万俟
+氣沖沖	气冲冲
+氣燄	气焰
+燄	焰
+氣	气
+气	气
+細緻	细致
+精緻	精致
+標緻	标致
+別緻	别致
+致	致
+緻	致
+製版	制版
+製成	制成
+製品	制品
+製片	制片
+製造	制造
+製圖	制图
+製作	制作
+縫製	缝制
+巨製	巨制
+煉製	炼制
+釀製	酿制
+炮製	炮制
+特製	特制
+預製	预制
+制	制
+製	制
+穀賤傷農	谷贱伤农
+穀神星	谷神星
+鬼谷子	鬼谷子
+穀子	谷子
+打穀	打谷
+穀場	谷场
+穀物	谷物
+穀粒	谷粒
+穀類	谷类
+穀草	谷草
+穀倉	谷仓
+穀苗	谷苗
+穀種	谷种
+穀穗	谷穗
+穀殻	谷壳
+包穀	包谷
+稻穀	稻谷
+五穀	五谷
+米穀	米谷
+秕穀	秕谷
+曬穀	晒谷
+谷	谷
+穀	谷
+榖	谷
+后妃	后妃
+后稷	后稷
+后土	后土
+后羿	后羿
+皇后	皇后
+母后	母后
+王后	王后
+太后	太后
+後	后
+后	后
+地方誌	地方志
+標誌	标志
+墓誌	墓志
+日誌	日志
+碑誌	碑志
+縣誌	县志
+雜誌	杂志
+志	志
+誌	志
+彆扭	别扭
+別	别
+彆	别
+彙報	汇报
+詞彙	词汇
+字彙	字汇
+匯	汇
+彙	汇
+辭	辞
+辞	辞
+詞	词
+機	机
+机	机
+髮廊	发廊
+髮妻	发妻
+髮型	发型
+發睏	发困
+捲土重來	卷土重来
+捲心菜	卷心菜
+捲鋪蓋	卷铺盖
+捲尺	卷尺
+捲入	卷入
+捲動	卷动
+捲成	卷成
+捲曲	卷曲
+捲款	卷款
+捲簾	卷帘
+捲紙	卷纸
+捲縮	卷缩
+捲舌	卷舌
+捲袖	卷袖
+捲走	卷走
+捲起	卷起
+捲門	卷门
+捲雲	卷云
+捲鬚	卷须
+春捲	春卷
+煙捲	烟卷
+紙捲	纸卷
+捲軸	卷轴
+席捲	席卷
+舒捲	舒卷
+風捲殘雲	风卷残云
+風馳電捲	风驰电卷
+龍捲風	龙卷风
+蛋捲	蛋卷
+硃卷	朱卷
+怒髮衝冠	怒发冲冠
+長髮	长发
+短髮	短发
+白髮	白发
+黑髮	黑发
+金髮	金发
+紅髮	红发
+銀髮	銀发
+染髮	染发
+編髮	编发
+毫髮	毫发
+護髮	护发
+假髮	假发
+結髮	结发
+捲髮	卷发
+理髮	理发
+落髮	落发
+毛髮	毛发
+美髮	美发
+散髮	散发
+燙髮	烫发
+勢頭	势头
+頭髮	头发
+秀髮	秀发
+剃发	剃髮
+一髮	一发
+發	发
+髮	发
+捲	卷
+人云亦云	人云亦云
+不知所云	不知所云
+雲遊	云游
+雲	云
+云	云
+子丑寅卯	子丑寅卯
+生旦净末丑	生旦淨末丑
+丑時	丑时
+丑旦	丑旦
+丑角	丑角
+小丑	小丑
+醜	丑
+丑	丑
+蘿蔔	萝卜
+卜	卜
+蔔	卜
+沖茶	冲茶
+沖淡	冲淡
+沖服	冲服
+沖積	冲积
+沖涼	冲凉
+沖天	冲天
+沖繩	冲绳
+沖洗	冲洗
+沖毀	冲毁
+喜沖沖	喜冲冲
+衝	冲
+沖	冲
+冲	冲
+出遊	出游
+出	出
+齣	出
+線	线
+綫	线
+覈實	核实
+覈算	核算
+核	核
+覈	核
+迴路	回路
+迴廊	回廊
+回遊	回游
+縈迴	萦回
+迂迴	迂回
+回	回
+迴	回
+廻	回
+鼕鼕	冬冬
+冬	冬
+鼕	冬
+鹹菜	咸菜
+咸	咸
+鹹	咸
+清心寡慾	清心寡欲
+克慾修行	克欲修行
+慾不可縱	欲不可纵
+人之大慾	人之大欲
+求知慾	求知欲
+慾火	欲火
+慾望	欲望
+禁慾	禁欲
+利慾	利欲
+情慾	情欲
+肉慾	肉欲
+色慾	色欲
+食慾	食欲
+私慾	私欲
+獸慾	兽欲
+縱慾	纵欲
+性慾	性欲
+六慾	六欲
+嗜慾	嗜欲
+欲	欲
+慾	欲
+準繩	准绳
+準時	准时
+準頭	准头
+準備	准备
+準確	准确
+為準	为准
+標準	标准
+標籤	标签
+水準	水准
+基準	基准
+對準	对准
+准	准
+準	准
+標	标
+註冊	注册
+註銷	注销
+註解	注解
+註疏	注疏
+評註	评注
+附註	附注
+加註	加注
+注	注
+註	注
+兇暴	凶暴
+兇器	凶器
+兇手	凶手
+元兇	元凶
+正兇	正凶
+逞兇	逞凶
+凶	凶
+兇	凶
+揚	扬
+䬗	扬
+颺	飏
+宴	宴
+醼	宴
+讌	䜩
+咬	咬
+齩	咬
+䶧	咬
+豆	豆
+荳	豆
+韭	韭
+韮	韭
+箋	笺
+牋	笺
+團	团
+糰	团
+滷雞	卤鸡
+滷味	卤味
+滷菜	卤菜
+茶滷	茶卤
+鹽滷	盐卤
+鹵	卤
+滷	卤
+呆	呆
+獃	呆
+泛	泛
+氾	泛
+汎	泛
+媯	妫
+嬀	妫
+眾	众
+衆	众
+鈎	钩
+鉤	钩
+緔	绱
+鞝	绱
+銳	锐
+鋭	锐
+贋	赝
+贗	赝
+贓	赃
+贜	赃
+粗	粗
+麤	粗
+關	关
+関	关
+飢	饥
+饑	饥
+款	款
+欵	款
+朧	胧
+蒙	蒙
+懞	蒙
+罵	骂
+駡	骂
+髒	脏
+臟	脏
+鰐	鳄
+鱷	鳄
+鳧	凫
+鳬	凫
+雞	鸡
+賫	赍
+齎	赍
+筘	筘
+簆	筘
+唚	吣
+吢	吣
+群	群
+羣	群
+嘆	叹
+歎	叹
+剃	剃
+鬀	剃
+鷄	鸡
+頹	颓
+頽	颓
+顏	颜
+顔	颜
+炮	炮
+砲	炮
+礮	炮
+啓	启
+啟	启
+茶几	茶几
+幾	几
+几	几
+德	德
+悳	德
+愨	悫
+慤	悫
+克	克
+剋	克
+尅	克
+罈罈罐罐	坛坛罐罐
+瓶瓶罈罈	瓶瓶坛坛
+醋罈	醋坛
+罈子	坛子
+酒罈	酒坛
+壇	坛
+壜	坛
+罎	坛
+罈	坛
+昇華	升华
+畢昇	毕升
+高昇	高升
+歌舞昇平	歌舞升平
+升	升
+昇	升
+陞	升
+偽	伪
+僞	伪
+獲	获
+穫	获
+縧	绦
+絛	绦
+繡	绣
+綉	绣
+鉢	钵
+缽	钵
+蠟	蜡
+蜡	蜡
+采薪之憂	采薪之忧
+興高采烈	兴高采烈
+無精打采	无精打采
+采風	采风
+風采	风采
+精采	精采
+神采	神采
+多采	多采
+喝采	喝采
+采緝	采缉
+彩	彩
+綵	彩
+採	采
+埰	采
+廁	厕
+厠	厕
+搗	捣
+擣	捣
+溈	沩
+潙	沩
+為	为
+爲	为
+產	产
+産	产
+瘻	瘘
+瘺	瘘
+灶	灶
+竈	灶
+絕	绝
+絶	绝
+綠	绿
+緑	绿
+繃	绷
+綳	绷
+凼	凼
+氹	凼
+床	床
+牀	床
+牆	墙
+墻	墙
+獎	奖
+奬	奖
+眥	眦
+眦	眦
+稈	秆
+秆	秆
+恥	耻
+耻	耻
+薴	苧
+苎	苧
+蘋	苹
+苹	苹
+蘊	蕴
+藴	蕴
+說	说
+説	说
+謠	谣
+謡	谣
+謭	谫
+譾	谫
+竪	竖
+豎	竖
+醖	酝
+醞	酝
+錄	录
+録	录
+鏽	锈
+銹	锈
+鐝	镢
+钁	䦆
+閱	阅
+閲	阅
+妝	妆
+粧	妆
+閑靜	闲静
+閑居	闲居
+閒	闲
+閑	闲
+遊山玩水	游山玩水
+遊伴	游伴
+遊程	游程
+遊春	游春
+遊方	游方
+遊記	游记
+遊街	游街
+遊客	游客
+遊樂	游乐
+遊廊	游廊
+遊牧	游牧
+遊人	游人
+遊子	游子
+遊俠	游侠
+遊民	游民
+遊蕩	游荡
+遊說	游说
+遊仙	游仙
+遊憩	游憩
+遊閑	游闲
+遊戲	游戏
+遊手	游手
+遊魂	游魂
+遊獵	游猎
+遊玩	游玩
+遊園	游园
+遊遍	游遍
+遊興	游兴
+遊舫	游舫
+遊艇	游艇
+遊藝	游艺
+遊行	游行
+遊覽	游览
+遊逛	游逛
+遊醫	游医
+遊學	游学
+暢遊	畅游
+串遊	串游
+春遊	春游
+導遊	导游
+交遊	交游
+郊遊	郊游
+倦遊	倦游
+冶遊	冶游
+漫遊	漫游
+夢遊	梦游
+嬉遊	嬉游
+巡遊	巡游
+環遊	环游
+旅遊	旅游
+浪遊	浪游
+神遊	神游
+秋遊	秋游
+仙遊	仙游
+遨遊	遨游
+野遊	野游
+夜遊	夜游
+游	游
+遊	游
+錶蒙子	表蒙子
+錶帶	表带
+錶鏈	表链
+錶盤	表盘
+錶針	表针
+電子錶	电子表
+電鍍錶	电度表
+防水錶	防水表
+馬蹄錶	马蹄表
+夜光錶	夜光表
+掛錶	挂表
+懷錶	怀表
+秒錶	秒表
+馬錶	马表
+鐘錶	钟表
+跑錶	跑表
+手錶	手表
+停錶	停表
+表	表
+錶	表
+癥結	症结
+症	症
+癥	症
+痴	痴
+癡	痴
+白洋淀	白洋淀
+荷花淀	荷花淀
+水淀	水淀
+海淀	海淀
+東淀	东淀
+澱	淀
+淀	淀
+嚮導	向导
+嚮應	响应
+嚮往	向往
+向	向
+嚮	向
+曏	向
+紮營	扎营
+駐紮	驻扎
+扎	扎
+紮	扎
+占卜	占卜
+占卦	占卦
+占夢	占梦
+占星	占星
+佔	占
+占	占
+託名	托名
+託收	托收
+信託	信托
+委託	委托
+拜託	拜托
+付託	付托
+寄託	寄托
+請託	请托
+受託	受托
+依託	依托
+囑託	嘱托
+轉託	转托
+托	托
+託	托
+湧	涌
+涌	涌
+累	累
+纍	累
+睏憊	困惫
+睏乏	困乏
+困	困
+睏	困
+左鄰右舍	左邻右舍
+舍利	舍利
+舍弟	舍弟
+宿舍	宿舍
+屋舍	屋舍
+田舍	田舍
+校舍	校舍
+民舍	民舍
+茅舍	茅舍
+老舍	老舍
+房舍	房舍
+農舍	农舍
+豬舍	猪舍
+捨	舍
+舍	舍
+槓	杠
+杠	杠
+僱員	雇员
+雇	雇
+僱	雇
+颳倒	刮倒
+刮	刮
+颳	刮
+狸	狸
+貍	狸
+跌跤	跌交
+交	交
+跤	交
+姪媳婦	侄媳妇
+姪女	侄女
+姪孫	侄孙
+侄	侄
+姪	侄
+勳	勋
+勛	勋
+鞦韆	秋千
+盪鞦韆	荡秋千
+蕩	荡
+盪	荡
+秋	秋
+不寒而慄	不寒而栗
+顫慄	颤栗
+戰慄	战栗
+栗	栗
+慄	栗
+細嚼慢嚥	细嚼慢咽
+狼吞虎嚥	狼吞虎咽
+嚥氣	咽气
+下嚥	下咽
+咽	咽
+嚥	咽
+弔民伐罪	吊民伐罪
+形影相弔	形影相吊
+提心弔膽	提心吊胆
+弔喪	吊丧
+弔慰	吊慰
+弔唁	吊唁
+吊	吊
+弔	吊
+英吋	英寸
+方腊	方腊
+臘	腊
+鄉愿	乡愿
+願	愿
+愿	愿
+古蹟	古迹
+史蹟	史迹
+跡	迹
+蹟	迹
+淨	净
+凈	净
+僥倖	侥幸
+僥	侥
+幸	幸
+倖	幸
+蠔	蚝
+蚝	蚝
+柜柳	柜柳
+櫃	柜
+拉縴	拉纤
+縴夫	纤夫
+縴路	纤路
+縴繩	纤绳
+纖	纤
+縴	纤
+厚朴	厚朴
+朴刀	朴刀
+朴硝	朴硝
+樸	朴
+朴	朴
+鍾靈毓秀	钟灵毓秀
+一見鍾情	一见钟情
+千鍾粟	千钟粟
+龍鍾	龙钟
+獨鍾	独钟
+漢鍾離	汉钟离
+所鍾	所钟
+鍾離	钟离
+鍾愛	钟爱
+鍾馗	钟馗
+鍾山	钟山
+鐘	钟
+鍾	钟
+霑恩	沾恩
+霑霈	沾霈
+霑濡	沾濡
+霑渥	沾渥
+霑衣	沾衣
+霑醉	沾醉
+霑	沾
+著名	著名
+著稱	著称
+著述	著述
+著作	著作
+著書	著书
+著績	著绩
+著錄	著录
+著文	著文
+著有	著有
+著者	著者
+見微知著	见微知著
+信義素著	信义素著
+顯著	显著
+論著	论著
+編著	编著
+炳著	炳著
+昭著	昭著
+大著	大著
+合著	合著
+巨著	巨著
+鉅著	钜著
+較著	较著
+舊著	旧著
+毛著	毛著
+名著	名著
+暴著	暴著
+卓著	卓著
+土著	土著
+新著	新著
+玄著	玄著
+遺著	遗著
+譯著	译著
+原著	原著
+專著	专著
+撰著	撰著
+拙著	拙著
+著	着
+着	着
+扭轉乾坤	扭转乾坤
+旋乾轉坤	旋乾转坤
+朗朗乾坤	朗朗乾坤
+蒐羅	搜罗
+蒐集	搜集
+蒐錄	搜录
+搜	搜
+蒐	搜
+抽籤	抽签
+籤詩	签诗
+籤條	签条
+籤筒	签筒
+籤文	签文
+籤語	签语
+求籤	求签
+竹籤	竹签
+芸籤縹帶	芸签缥带
+蕓	芸
+芸	芸
+萬籤插架	万签插架
+萬	万
+解籤	解签
+籤譜	签谱
+中籤	中签
+簽	签
+籤	签
+鍊石補天	炼石补天
+鍊而愈精	炼而愈精
+久鍊成鋼	久炼成钢
+千錘百鍊	千锤百炼
+百鍊	百炼
+鍊鋁	炼铝
+鍊銅	炼铜
+鍊句	炼句
+焠鍊	淬炼
+鍛鍊	锻炼
+錘鍊	锤炼
+捶鍊	锤炼
+錘	锤
+鎚	锤
+磨鍊	磨练
+鍊氣	练气
+洗鍊	洗练
+金鍊	金链
+鍊子	链子
+拉鍊	拉链
+手鍊	手链
+鐵鍊	铁链
+項鍊	项链
+鍊	炼
+煉	炼
+練	练
+鏈	链
+巡察	巡查
+稽察	稽查
+詢察	询查
+細察	细查
+螢光	荧光
+螢幕	荧幕
+螢屏	荧屏
+熒	荧
+螢	萤
+霉	霉
+黴	霉
+艷	艳
+豔	艳
+闇	暗
+證	证
+証	证
+嘗	尝
+嚐	尝
+吃	吃
+喫	吃
+鋪	铺
+舖	铺
+唇	唇
+脣	唇
+殼	壳
+遁	遁
+遯	遁
+姐	姐
+姊	姐
+污	污
+汙	污
+個	个
+箇	个
+絃	弦
+嘩	哗
+譁	哗
+焊	焊
+銲	焊
+饋	馈
+餽	馈
+梁	梁
+樑	梁
+具	具
+俱	具
+俬	私
+私	私
+鑒	鉴
+鑑	鉴
+盃	杯
+濕	湿
+溼	湿
+局	局
+侷	局
+跼	局
+鉅	钜
+綑	捆
+囉	啰
+裊	袅
+嫋	袅
+嬝	袅
+慼	戚
+褶疊	折叠
+褶紙	折纸
+疊	叠
+撚	捻
+鉗	钳
+箝	钳
+鎔爐	熔炉
+鎔劑	熔剂
+鎔融	熔融
+鎔銷	熔销
+鎔鑄	熔铸
+鎔	镕
+砂金	沙金
+砂土	沙土
+搧	扇
+像片	相片
+毀	毁
+燬	毁
+虱目魚	虱目鱼
+蝨	虱
+讚	赞
+贊	赞
+纔	才
+藷	薯
+搾	榨
+衊	蔑
+痠	酸
+袴	绔
+絝	绔
+縐摺	绉褶
+皺摺	皱褶
+摺紙	折纸
+摺	折
+縐	绉
+五嶽	五岳
+東嶽	东岳
+南嶽	南岳
+西嶽	西岳
+北嶽	北岳
+山嶽	山岳
+中嶽	中岳
+岱嶽	岱岳
+奇巖	奇岩
+峭壁巉巖	峭壁巉岩
+巖穴	岩穴
+鑿巖成室	凿岩成室
+鑿通巖洞	凿通岩洞
+巖	岩
+撣	掸
+撢	掸
+久病不癒	久病不愈
+不藥而癒	不药而愈
+固疾痊癒	固疾痊愈
+療癒	疗愈
+癒復	愈复
+病癒	病愈
+痊癒	痊愈
+治癒	治愈
+癒	愈
+欞	棂
+櫺	棂
+呼籲	呼吁
+籲求	吁求
+籲請	吁请
+籲防	吁防
+籲	吁
+叮噹	叮当
+叮叮噹噹	叮叮当当
+疏濬	疏浚
+濬	浚
+鏟	铲
+剷	铲
+碳煙	炭烟
+煙薰	烟熏
+燻	熏
+煙	烟
+菸	烟
+烟	烟
+翫	玩
+羨	羡
+繇役	徭役
+瀰漫	弥漫
+彌	弥
+瀰	弥
+慇	殷
+懃	勤
+諮	谘
+繮	缰
+韁	缰
+塚	冢
+燿	耀
+暱	昵
+蓆	席
+甕	瓮
+名譟一時	名噪一时
+鼓譟	鼓噪
+譟	噪
+閧	哄
+鬨	哄
+份子	分子
+沍	冱
+釦	扣
+籐	藤
+燐	磷
+簷	檐
+悽	凄
+礡	礴
+羶	膻
+蠍	蝎
+毬	球
+懮	忧
+稜	棱
+棱	棱
+餵	喂
+摀	捂
+孃	娘
+勗	勖
+醃	腌
+排泄	排泄
+洩	泄
+枒	丫
+倡言	昌言
+瞇	眯
+佛佗	佛陀
+依徬	依傍
+徬	彷
+彷如	仿如
+近傍	近旁
+彷彿	仿佛
+仿	仿
+倣	仿
+彿	佛
+依杖	依仗
+僇	戮
+撫卹	抚恤
+卹養	恤养
+卹	恤
+嚙	啮
+齧	啮
+囓	啮
+唸	念
+喨	亮
+咷	啕
+槖	橐
+靷	纼
+搥	捶
+怵目	触目
+斂	敛
+歛	敛
+餬	糊
+塤	埙
+壎	埙
+搆和	媾和
+搆	构
+構	构
+搨	拓
+轝	舆
+檝	楫
+賸	剩
+皁	皂
+鰲	鳌
+鼇	鳌
+琺瑯	珐琅
+瑯	琅
+蔴	麻
+痀	佝
+囍	禧
+謚	谥
+諡	谥
+藍	蓝
+縷	缕
+襤	褴
+褸	褛
+蹧	糟
+罣礙	挂碍
+掛	挂
+罣	挂
+積餚於案	积肴于案
+佳餚	佳肴
+酒餚	酒肴
+餚饌	肴馔
+菜餚	菜肴
+餚	肴
+翺	翱
+蠧	蠹
+踡	蜷
+踰	逾
+槍	枪
+鎗	枪
+阨	厄
+隄	堤
+鱉	鳖
+鼈	鳖
+箎	篪
+睪	睾
+彞	彝
+鐫	镌
+鎸	镌
+於	于
+亙	亘
+鋁	铝
+極	极
+鍁	锨
+詠	咏
+瓊	琼
+蒓	莼
+鮝	鲞
+鷀	鹚
+種	种
+妒	妒
+和	和
+儻	傥
+倘	倘
+礆	硷
+鮎	鲇
+㠏	㟆
+㩜	㨫
+䊷	䌶
+䋙	䌺
+䋻	䌾
+䝼	䞍
+䯀	䯅
+䱽	䲝
+䰾	鲃
+䲁	鳚
+丟	丢
+亂	乱
+亞	亚
+臥	卧
+佇	伫
+來	来
+侶	侣
+俁	俣
+俔	伣
+俠	侠
+倀	伥
+倆	俩
+倈	俫
+倉	仓
+們	们
+倫	伦
+偉	伟
+側	侧
+偵	侦
+傑	杰
+傖	伧
+傘	伞
+備	备
+傭	佣
+傯	偬
+傳	传
+傴	伛
+債	债
+傷	伤
+傾	倾
+僂	偻
+僅	仅
+僉	佥
+僑	侨
+僕	仆
+僨	偾
+價	价
+儀	仪
+儂	侬
+億	亿
+儈	侩
+儉	俭
+儐	傧
+儔	俦
+儕	侪
+償	偿
+優	优
+儲	储
+儷	俪
+儸	㑩
+儺	傩
+儼	俨
+豐	丰
+兌	兑
+兒	儿
+兗	兖
+內	内
+兩	两
+冊	册
+冪	幂
+凍	冻
+凜	凛
+凱	凯
+刪	删
+剄	刭
+則	则
+剎	刹
+剗	刬
+剛	刚
+剝	剥
+剮	剐
+剴	剀
+創	创
+劇	剧
+劉	刘
+劊	刽
+劌	刿
+劍	剑
+劏	㓥
+劑	剂
+劚	㔉
+勁	劲
+動	动
+務	务
+勝	胜
+勞	劳
+勢	势
+勩	勚
+勱	劢
+勵	励
+勸	劝
+勻	匀
+匭	匦
+匱	匮
+區	区
+協	协
+卻	却
+厙	厍
+厭	厌
+厲	厉
+厴	厣
+參	参
+叄	叁
+叢	丛
+吒	咤
+吳	吴
+吶	呐
+呂	吕
+咼	呙
+員	员
+唄	呗
+問	问
+啞	哑
+啢	唡
+喎	㖞
+喚	唤
+喪	丧
+喬	乔
+單	单
+喲	哟
+嗆	呛
+嗇	啬
+嗊	唝
+嗎	吗
+嗚	呜
+嗩	唢
+嗶	哔
+嘍	喽
+嘔	呕
+嘖	啧
+嘜	唛
+嘮	唠
+嘯	啸
+嘰	叽
+嘵	哓
+嘸	呒
+嘽	啴
+噓	嘘
+噚	㖊
+噝	咝
+噠	哒
+噥	哝
+噦	哕
+噯	嗳
+噲	哙
+噴	喷
+噸	吨
+嚀	咛
+嚇	吓
+嚌	哜
+嚕	噜
+嚦	呖
+嚨	咙
+嚲	亸
+嚳	喾
+嚴	严
+嚶	嘤
+囀	啭
+囁	嗫
+囂	嚣
+囅	冁
+囈	呓
+囑	嘱
+囪	囱
+圇	囵
+國	国
+圍	围
+園	园
+圓	圆
+圖	图
+垵	埯
+埡	垭
+執	执
+堅	坚
+堊	垩
+堖	垴
+堝	埚
+堯	尧
+報	报
+場	场
+塊	块
+塋	茔
+塏	垲
+塒	埘
+塗	涂
+塢	坞
+塵	尘
+塹	堑
+墊	垫
+墜	坠
+墮	堕
+墳	坟
+墾	垦
+壋	垱
+壓	压
+壘	垒
+壙	圹
+壚	垆
+壞	坏
+壟	垄
+壠	垅
+壢	坜
+壩	坝
+壯	壮
+壺	壶
+壼	壸
+壽	寿
+夠	够
+夢	梦
+夾	夹
+奐	奂
+奧	奥
+奩	奁
+奪	夺
+奮	奋
+奼	姹
+姍	姗
+娛	娱
+婁	娄
+婦	妇
+婭	娅
+媧	娲
+媼	媪
+媽	妈
+嫗	妪
+嫵	妩
+嫻	娴
+嫿	婳
+嬈	娆
+嬋	婵
+嬌	娇
+嬙	嫱
+嬡	嫒
+嬤	嬷
+嬪	嫔
+嬰	婴
+嬸	婶
+孌	娈
+孫	孙
+學	学
+孿	孪
+宮	宫
+寢	寝
+實	实
+寧	宁
+審	审
+寫	写
+寬	宽
+寵	宠
+寶	宝
+將	将
+專	专
+尋	寻
+對	对
+導	导
+尷	尴
+屆	届
+屍	尸
+屓	屃
+屜	屉
+屢	屡
+層	层
+屨	屦
+屬	属
+岡	冈
+峴	岘
+島	岛
+峽	峡
+崍	崃
+崗	岗
+崢	峥
+崬	岽
+嵐	岚
+嶁	嵝
+嶄	崭
+嶇	岖
+嶔	嵚
+嶗	崂
+嶠	峤
+嶢	峣
+嶧	峄
+嶮	崄
+嶴	岙
+嶸	嵘
+嶺	岭
+嶼	屿
+巋	岿
+巒	峦
+巔	巅
+巰	巯
+帥	帅
+師	师
+帳	帐
+帶	带
+幀	帧
+幃	帏
+幗	帼
+幘	帻
+幟	帜
+幣	币
+幫	帮
+幬	帱
+庫	库
+廂	厢
+廄	厩
+廈	厦
+廚	厨
+廝	厮
+廟	庙
+廠	厂
+廡	庑
+廢	废
+廣	广
+廩	廪
+廬	庐
+廳	厅
+弒	弑
+弳	弪
+張	张
+強	强
+彈	弹
+彎	弯
+彥	彦
+徑	径
+從	从
+徠	徕
+徹	彻
+恆	恒
+悅	悦
+悞	悮
+悵	怅
+悶	闷
+惡	恶
+惱	恼
+惲	恽
+惻	恻
+愛	爱
+愜	惬
+愴	怆
+愷	恺
+愾	忾
+態	态
+慍	愠
+慘	惨
+慚	惭
+慟	恸
+慣	惯
+慪	怄
+慫	怂
+慮	虑
+慳	悭
+慶	庆
+憊	惫
+憐	怜
+憑	凭
+憒	愦
+憚	惮
+憤	愤
+憫	悯
+憮	怃
+憲	宪
+憶	忆
+懇	恳
+應	应
+懌	怿
+懍	懔
+懟	怼
+懣	懑
+懨	恹
+懲	惩
+懶	懒
+懷	怀
+懸	悬
+懺	忏
+懼	惧
+懾	慑
+戀	恋
+戇	戆
+戔	戋
+戧	戗
+戩	戬
+戰	战
+戱	戯
+戲	戏
+戶	户
+拋	抛
+挩	捝
+挾	挟
+捫	扪
+掃	扫
+掄	抡
+掗	挜
+掙	挣
+揀	拣
+換	换
+揮	挥
+損	损
+搖	摇
+搵	揾
+搶	抢
+摑	掴
+摜	掼
+摟	搂
+摯	挚
+摳	抠
+摶	抟
+摻	掺
+撈	捞
+撏	挦
+撐	撑
+撓	挠
+撝	㧑
+撟	挢
+撥	拨
+撫	抚
+撲	扑
+撳	揿
+撻	挞
+撾	挝
+撿	捡
+擁	拥
+擄	掳
+擇	择
+擊	击
+擋	挡
+擓	㧟
+擔	担
+據	据
+擠	挤
+擬	拟
+擯	摈
+擰	拧
+擱	搁
+擲	掷
+擴	扩
+擷	撷
+擺	摆
+擻	擞
+擼	撸
+擾	扰
+攄	摅
+攆	撵
+攏	拢
+攔	拦
+攖	撄
+攙	搀
+攛	撺
+攜	携
+攝	摄
+攢	攒
+攣	挛
+攤	摊
+攪	搅
+攬	揽
+敗	败
+敘	叙
+敵	敌
+數	数
+斃	毙
+斕	斓
+斬	斩
+斷	断
+時	时
+晉	晋
+晝	昼
+暈	晕
+暉	晖
+暘	旸
+暢	畅
+暫	暂
+曄	晔
+曇	昙
+曉	晓
+曖	暧
+曠	旷
+曨	昽
+曬	晒
+書	书
+會	会
+東	东
+柵	栅
+桿	杆
+梔	栀
+梘	枧
+條	条
+梟	枭
+梲	棁
+棄	弃
+棖	枨
+棗	枣
+棟	栋
+棧	栈
+棲	栖
+棶	梾
+椏	桠
+楊	杨
+楓	枫
+楨	桢
+業	业
+榪	杩
+榮	荣
+榲	榅
+榿	桤
+槤	梿
+槧	椠
+槨	椁
+槳	桨
+樁	桩
+樂	乐
+樅	枞
+樓	楼
+樞	枢
+樣	样
+樹	树
+樺	桦
+橈	桡
+橋	桥
+橢	椭
+橫	横
+檁	檩
+檉	柽
+檔	档
+檜	桧
+檟	槚
+檢	检
+檣	樯
+檮	梼
+檳	槟
+檸	柠
+檻	槛
+櫓	橹
+櫚	榈
+櫛	栉
+櫝	椟
+櫞	橼
+櫟	栎
+櫥	橱
+櫧	槠
+櫨	栌
+櫪	枥
+櫫	橥
+櫬	榇
+櫱	蘖
+櫳	栊
+櫸	榉
+櫻	樱
+欄	栏
+權	权
+欏	椤
+欒	栾
+欖	榄
+欽	钦
+歐	欧
+歟	欤
+歡	欢
+歲	岁
+歸	归
+歿	殁
+殘	残
+殞	殒
+殤	殇
+殨	㱮
+殫	殚
+殮	殓
+殯	殡
+殰	㱩
+殲	歼
+殺	杀
+毆	殴
+毿	毵
+氂	牦
+氈	毡
+氌	氇
+氫	氢
+氬	氩
+氳	氲
+鬱金香	郁金香
+鬱悶	郁闷
+鬱鬱	郁郁
+陰鬱	阴郁
+沈鬱	沉郁
+蒼鬱	苍郁
+憂鬱	忧郁
+悒鬱	悒郁
+抑鬱	抑郁
+積鬱	积郁
+鬱	郁
+憂	忧
+瀋大鐵路	沈大铁路
+瀋大高速	沈大高速
+瀋大線	沈大线
+瀋吉鐵路	沈吉铁路
+瀋吉高速	沈吉高速
+瀋吉線	沈吉线
+瀋山高速	沈山高速
+瀋山鐵路	沈山铁路
+瀋山線	沈山线
+瀋陽	沈阳
+瀋哈	沈哈
+京瀋	京沈
+遼瀋	辽沈
+瀋	沈
+墨瀋未乾	墨渖未干
+石沈大海	石沉大海
+魚沈雁杳	鱼沉雁杳
+破釜沈舟	破釜沉舟
+沈沈浮浮	沉沉浮浮
+沈浮	沉浮
+沈默	沉默
+沈重	沉重
+沈思	沉思
+沈澱	沉淀
+沈穩	沉稳
+沈浸	沉浸
+沈悶	沉闷
+沈靜	沉静
+沈醉	沉醉
+沈迷	沉迷
+沈寂	沉寂
+沈入	沉入
+沈沈	沉沉
+沈落	沉落
+沈睡	沉睡
+沈潛	沉潜
+沈淪	沉沦
+沈吟	沉吟
+沈積	沉积
+沈著	沉着
+沈沒	沉没
+低沈	低沉
+消沈	消沉
+深沈	深沉
+浮沈	浮沉
+祕	秘
+決	决
+沒	没
+況	况
+洶	汹
+浹	浃
+涇	泾
+淒	凄
+涼	凉
+淚	泪
+淥	渌
+淪	沦
+淵	渊
+淶	涞
+淺	浅
+渙	涣
+減	减
+渦	涡
+測	测
+渾	浑
+湊	凑
+湞	浈
+湯	汤
+溝	沟
+溫	温
+滄	沧
+滅	灭
+滌	涤
+滎	荥
+滬	沪
+滯	滞
+滲	渗
+滸	浒
+滻	浐
+滾	滚
+滿	满
+漁	渔
+漚	沤
+漢	汉
+漣	涟
+漬	渍
+漲	涨
+漵	溆
+漸	渐
+漿	浆
+潁	颍
+潑	泼
+潔	洁
+潛	潜
+潤	润
+潯	浔
+潰	溃
+潷	滗
+潿	涠
+澀	涩
+澆	浇
+澇	涝
+澗	涧
+澠	渑
+澤	泽
+澦	滪
+澩	泶
+澮	浍
+濁	浊
+濃	浓
+濘	泞
+濟	济
+濤	涛
+濫	滥
+濰	潍
+濱	滨
+濺	溅
+濼	泺
+濾	滤
+瀅	滢
+瀆	渎
+瀇	㲿
+瀉	泻
+瀏	浏
+瀕	濒
+瀘	泸
+瀝	沥
+瀟	潇
+瀠	潆
+瀦	潴
+瀧	泷
+瀨	濑
+瀲	潋
+瀾	澜
+灃	沣
+灄	滠
+灑	洒
+灕	漓
+灘	滩
+灝	灏
+灠	漤
+灣	湾
+灤	滦
+灧	滟
+災	灾
+烏	乌
+烴	烃
+無	无
+煒	炜
+煢	茕
+煥	焕
+煩	烦
+煬	炀
+煱	㶽
+熅	煴
+熗	炝
+熱	热
+熲	颎
+熾	炽
+燁	烨
+燈	灯
+燉	炖
+燒	烧
+燙	烫
+燜	焖
+營	营
+燦	灿
+燭	烛
+燴	烩
+燶	㶶
+燼	烬
+燾	焘
+爍	烁
+爐	炉
+爛	烂
+爭	争
+爺	爷
+爾	尔
+牘	牍
+牽	牵
+犖	荦
+犢	犊
+犧	牺
+狀	状
+狹	狭
+狽	狈
+猙	狰
+猶	犹
+猻	狲
+獁	犸
+獄	狱
+獅	狮
+獨	独
+獪	狯
+獫	猃
+獮	狝
+獰	狞
+獱	㺍
+獵	猎
+獷	犷
+獸	兽
+獺	獭
+獻	献
+獼	猕
+玀	猡
+現	现
+琺	珐
+琿	珲
+瑋	玮
+瑒	玚
+瑣	琐
+瑤	瑶
+瑩	莹
+瑪	玛
+瑲	玱
+璉	琏
+璣	玑
+璦	瑷
+璫	珰
+環	环
+璽	玺
+瓏	珑
+瓔	璎
+瓚	瓒
+甌	瓯
+畝	亩
+畢	毕
+異	异
+疇	畴
+痙	痉
+痾	疴
+瘂	痖
+瘋	疯
+瘍	疡
+瘓	痪
+瘞	瘗
+瘡	疮
+瘧	疟
+瘮	瘆
+瘲	疭
+療	疗
+癆	痨
+癇	痫
+癉	瘅
+癘	疠
+癟	瘪
+癢	痒
+癤	疖
+癧	疬
+癩	癞
+癬	癣
+癭	瘿
+癮	瘾
+癰	痈
+癱	瘫
+癲	癫
+皚	皑
+皰	疱
+皸	皲
+皺	皱
+盜	盗
+盞	盏
+監	监
+盤	盘
+盧	卢
+睜	睁
+睞	睐
+瞘	眍
+瞜	䁖
+瞞	瞒
+瞶	瞆
+瞼	睑
+矓	眬
+矚	瞩
+眞	真
+矯	矫
+硏	研
+硜	硁
+硤	硖
+硨	砗
+硯	砚
+碩	硕
+碭	砀
+碸	砜
+確	确
+碼	码
+磑	硙
+磚	砖
+磣	碜
+磧	碛
+磯	矶
+磽	硗
+礎	础
+礙	碍
+礦	矿
+礪	砺
+礫	砾
+礬	矾
+礱	砻
+祿	禄
+禍	祸
+禎	祯
+禕	祎
+禡	祃
+禪	禅
+禮	礼
+禰	祢
+禱	祷
+禿	秃
+秈	籼
+稅	税
+稏	䅉
+稟	禀
+稱	称
+穌	稣
+積	积
+穎	颖
+穠	秾
+穡	穑
+穢	秽
+穩	稳
+穭	稆
+窩	窝
+窪	洼
+窮	穷
+窯	窑
+窵	窎
+窶	窭
+窺	窥
+竄	窜
+竅	窍
+竇	窦
+竊	窃
+競	竞
+筆	笔
+筍	笋
+筧	笕
+筴	䇲
+箏	筝
+節	节
+範	范
+築	筑
+篋	箧
+篔	筼
+篤	笃
+篩	筛
+篳	筚
+簀	箦
+簍	篓
+簞	箪
+簡	简
+簣	篑
+簫	箫
+簹	筜
+簾	帘
+籃	篮
+籌	筹
+籙	箓
+籜	箨
+籟	籁
+籠	笼
+籩	笾
+籪	簖
+籬	篱
+籮	箩
+粵	粤
+糝	糁
+糞	粪
+糧	粮
+糲	粝
+糴	籴
+糶	粜
+糹	纟
+糾	纠
+紀	纪
+紂	纣
+約	约
+紅	红
+紆	纡
+紇	纥
+紈	纨
+紉	纫
+紋	纹
+納	纳
+紐	纽
+紓	纾
+純	纯
+紕	纰
+紖	纼
+紗	纱
+紘	纮
+紙	纸
+級	级
+紛	纷
+紜	纭
+紝	纴
+紡	纺
+紬	䌷
+細	细
+紱	绂
+紲	绁
+紳	绅
+紵	纻
+紹	绍
+紺	绀
+紼	绋
+紿	绐
+絀	绌
+終	终
+組	组
+絅	䌹
+絆	绊
+絎	绗
+結	结
+絞	绞
+絡	络
+絢	绚
+給	给
+絨	绒
+絰	绖
+統	统
+絲	丝
+絳	绛
+絹	绢
+綁	绑
+綃	绡
+綆	绠
+綈	绨
+綌	绤
+綏	绥
+綐	䌼
+經	经
+綜	综
+綞	缍
+綢	绸
+綣	绻
+綬	绶
+維	维
+綯	绹
+綰	绾
+綱	纲
+網	网
+綴	缀
+綸	纶
+綹	绺
+綺	绮
+綻	绽
+綽	绰
+綾	绫
+綿	绵
+緄	绲
+緇	缁
+緊	紧
+緋	绯
+緒	绪
+緓	绬
+緗	缃
+緘	缄
+緙	缂
+緝	缉
+緞	缎
+締	缔
+緡	缗
+緣	缘
+緦	缌
+編	编
+緩	缓
+緬	缅
+緯	纬
+緱	缑
+緲	缈
+緶	缏
+緹	缇
+縈	萦
+縉	缙
+縊	缢
+縋	缒
+縑	缣
+縕	缊
+縗	缞
+縛	缚
+縝	缜
+縞	缟
+縟	缛
+縣	县
+縫	缝
+縭	缡
+縮	缩
+縱	纵
+縲	缧
+縳	䌸
+縵	缦
+縶	絷
+縹	缥
+總	总
+績	绩
+繅	缫
+繆	缪
+繒	缯
+織	织
+繕	缮
+繚	缭
+繞	绕
+繢	缋
+繩	绳
+繪	绘
+繭	茧
+繯	缳
+繰	缲
+繳	缴
+繸	䍁
+繹	绎
+繼	继
+繽	缤
+繾	缱
+繿	䍀
+纈	缬
+纊	纩
+續	续
+纏	缠
+纓	缨
+纘	缵
+纜	缆
+罌	罂
+罰	罚
+罷	罢
+羅	罗
+羆	罴
+羈	羁
+羋	芈
+羥	羟
+義	义
+習	习
+翹	翘
+耬	耧
+耮	耢
+聖	圣
+聞	闻
+聯	联
+聰	聪
+聲	声
+聳	耸
+聵	聩
+聶	聂
+職	职
+聹	聍
+聽	听
+聾	聋
+肅	肃
+脅	胁
+脈	脉
+脛	胫
+脫	脱
+脹	胀
+腎	肾
+腖	胨
+腡	脶
+腦	脑
+腫	肿
+腳	脚
+腸	肠
+膃	腽
+膚	肤
+膠	胶
+膩	腻
+膽	胆
+膾	脍
+膿	脓
+臉	脸
+臍	脐
+臏	膑
+臚	胪
+臠	脔
+臢	臜
+臨	临
+與	与
+興	兴
+舉	举
+舊	旧
+艙	舱
+艤	舣
+艦	舰
+艫	舻
+艱	艰
+芻	刍
+苧	苎
+茲	兹
+荊	荆
+莊	庄
+莖	茎
+莢	荚
+莧	苋
+華	华
+萇	苌
+萊	莱
+萵	莴
+葒	荭
+葤	荮
+葦	苇
+葷	荤
+蒔	莳
+蒞	莅
+蒼	苍
+蓀	荪
+蓋	盖
+蓮	莲
+蓯	苁
+蓽	荜
+蔞	蒌
+蔣	蒋
+蔥	葱
+蔦	茑
+蔭	荫
+蕁	荨
+蕆	蒇
+蕎	荞
+蕒	荬
+蕕	莸
+蕘	荛
+蕢	蒉
+蕪	芜
+蕭	萧
+蕷	蓣
+薀	蕰
+薈	荟
+薊	蓟
+薌	芗
+薔	蔷
+薘	荙
+薟	莶
+薦	荐
+薩	萨
+薳	䓕
+薺	荠
+藎	荩
+藝	艺
+藪	薮
+藶	苈
+藹	蔼
+藺	蔺
+蘄	蕲
+蘆	芦
+蘇	苏
+蘚	藓
+蘞	蔹
+蘢	茏
+蘭	兰
+蘺	蓠
+蘿	萝
+虆	蔂
+處	处
+虛	虚
+虜	虏
+號	号
+虧	亏
+虯	虬
+蛺	蛱
+蛻	蜕
+蜆	蚬
+蝕	蚀
+蝟	猬
+蝦	虾
+蝸	蜗
+螄	蛳
+螞	蚂
+螮	䗖
+螻	蝼
+螿	螀
+蟄	蛰
+蟈	蝈
+蟎	螨
+蟣	虮
+蟬	蝉
+蟯	蛲
+蟲	虫
+蟶	蛏
+蟻	蚁
+蠅	蝇
+蠆	虿
+蠐	蛴
+蠑	蝾
+蠣	蛎
+蠨	蟏
+蠱	蛊
+蠶	蚕
+蠻	蛮
+術	术
+衛	卫
+袞	衮
+補	补
+裝	装
+褌	裈
+褘	袆
+褲	裤
+褳	裢
+褻	亵
+襇	裥
+襏	袯
+襖	袄
+襝	裣
+襠	裆
+襪	袜
+襬	䙓
+襯	衬
+襲	袭
+見	见
+覎	觃
+規	规
+覓	觅
+視	视
+覘	觇
+覡	觋
+覥	觍
+覦	觎
+親	亲
+覬	觊
+覯	觏
+覲	觐
+覷	觑
+覺	觉
+覽	览
+覿	觌
+觀	观
+觴	觞
+觶	觯
+觸	触
+訁	讠
+訂	订
+訃	讣
+計	计
+訊	讯
+訌	讧
+討	讨
+訐	讦
+訒	讱
+訓	训
+訕	讪
+訖	讫
+記	记
+訛	讹
+訝	讶
+訟	讼
+訢	䜣
+訣	诀
+訥	讷
+訩	讻
+訪	访
+設	设
+許	许
+訴	诉
+訶	诃
+診	诊
+詁	诂
+詆	诋
+詎	讵
+詐	诈
+詒	诒
+詔	诏
+評	评
+詖	诐
+詗	诇
+詘	诎
+詛	诅
+詡	诩
+詢	询
+詣	诣
+試	试
+詩	诗
+詫	诧
+詬	诟
+詭	诡
+詮	诠
+詰	诘
+話	话
+該	该
+詳	详
+詵	诜
+詼	诙
+詿	诖
+誄	诔
+誅	诛
+誆	诓
+誇	夸
+認	认
+誑	诳
+誒	诶
+誕	诞
+誘	诱
+誚	诮
+語	语
+誠	诚
+誡	诫
+誣	诬
+誤	误
+誥	诰
+誦	诵
+誨	诲
+誰	谁
+課	课
+誶	谇
+誹	诽
+誼	谊
+誾	訚
+調	调
+諂	谄
+諄	谆
+談	谈
+諉	诿
+請	请
+諍	诤
+諏	诹
+諑	诼
+諒	谅
+論	论
+諗	谂
+諛	谀
+諜	谍
+諝	谞
+諞	谝
+諢	诨
+諤	谔
+諦	谛
+諧	谐
+諫	谏
+諭	谕
+諱	讳
+諳	谙
+諶	谌
+諷	讽
+諸	诸
+諺	谚
+諼	谖
+諾	诺
+謀	谋
+謁	谒
+謂	谓
+謄	誊
+謅	诌
+謊	谎
+謎	谜
+謐	谧
+謔	谑
+謖	谡
+謗	谤
+謙	谦
+講	讲
+謝	谢
+謨	谟
+謫	谪
+謬	谬
+謳	讴
+謹	谨
+謾	谩
+譅	䜧
+譎	谲
+譏	讥
+譖	谮
+識	识
+譙	谯
+譚	谭
+譜	谱
+譫	谵
+譯	译
+議	议
+譴	谴
+護	护
+譸	诪
+譽	誉
+讀	读
+變	变
+讎	雠
+讒	谗
+讓	让
+讕	谰
+讖	谶
+讜	谠
+讞	谳
+豈	岂
+豬	猪
+豶	豮
+貓	猫
+貙	䝙
+貝	贝
+貞	贞
+貟	贠
+負	负
+財	财
+貢	贡
+貧	贫
+貨	货
+販	贩
+貪	贪
+貫	贯
+責	责
+貯	贮
+貰	贳
+貲	赀
+貳	贰
+貴	贵
+貶	贬
+買	买
+貸	贷
+貺	贶
+費	费
+貼	贴
+貽	贻
+貿	贸
+賀	贺
+賁	贲
+賂	赂
+賃	赁
+賄	贿
+賅	赅
+資	资
+賈	贾
+賊	贼
+賑	赈
+賒	赊
+賓	宾
+賕	赇
+賙	赒
+賚	赉
+賜	赐
+賞	赏
+賠	赔
+賡	赓
+賢	贤
+賣	卖
+賤	贱
+賦	赋
+賧	赕
+質	质
+賬	账
+賭	赌
+賰	䞐
+賴	赖
+賵	赗
+賺	赚
+賻	赙
+購	购
+賽	赛
+賾	赜
+贄	贽
+贅	赘
+贇	赟
+贈	赠
+贍	赡
+贏	赢
+贐	赆
+贔	赑
+贖	赎
+贛	赣
+赬	赪
+趕	赶
+趙	赵
+趨	趋
+趲	趱
+踐	践
+踴	踊
+蹌	跄
+蹕	跸
+蹣	蹒
+蹤	踪
+蹺	跷
+躂	跶
+躉	趸
+躊	踌
+躋	跻
+躍	跃
+躑	踯
+躒	跞
+躓	踬
+躕	蹰
+躚	跹
+躡	蹑
+躥	蹿
+躦	躜
+躪	躏
+軀	躯
+車	车
+軋	轧
+軌	轨
+軍	军
+軑	轪
+軒	轩
+軔	轫
+軛	轭
+軟	软
+軤	轷
+軫	轸
+軲	轱
+軸	轴
+軹	轵
+軺	轺
+軻	轲
+軼	轶
+軾	轼
+較	较
+輅	辂
+輇	辁
+輈	辀
+載	载
+輊	轾
+輒	辄
+輓	挽
+輔	辅
+輕	轻
+輛	辆
+輜	辎
+輝	辉
+輞	辋
+輟	辍
+輥	辊
+輦	辇
+輩	辈
+輪	轮
+輬	辌
+輯	辑
+輳	辏
+輸	输
+輻	辐
+輾	辗
+輿	舆
+轀	辒
+轂	毂
+轄	辖
+轅	辕
+轆	辘
+轉	转
+轍	辙
+轎	轿
+轔	辚
+轟	轰
+轡	辔
+轢	轹
+轤	轳
+辦	办
+辮	辫
+辯	辩
+農	农
+逕	迳
+這	这
+連	连
+進	进
+運	运
+過	过
+達	达
+違	违
+遙	遥
+遜	逊
+遞	递
+遠	远
+適	适
+遲	迟
+遷	迁
+選	选
+遺	遗
+遼	辽
+邁	迈
+還	还
+邇	迩
+邊	边
+邏	逻
+邐	逦
+郟	郏
+郵	邮
+鄆	郓
+鄉	乡
+鄒	邹
+鄔	邬
+鄖	郧
+鄧	邓
+鄭	郑
+鄰	邻
+鄲	郸
+鄴	邺
+鄶	郐
+鄺	邝
+酇	酂
+酈	郦
+醫	医
+醬	酱
+醱	酦
+釀	酿
+釁	衅
+釃	酾
+釅	酽
+釋	释
+釐	厘
+釒	钅
+釓	钆
+釔	钇
+釕	钌
+釗	钊
+釘	钉
+釙	钋
+針	针
+釣	钓
+釤	钐
+釧	钏
+釩	钒
+釵	钗
+釷	钍
+釹	钕
+釺	钎
+鈀	钯
+鈁	钫
+鈃	钘
+鈄	钭
+鈈	钚
+鈉	钠
+鈍	钝
+鈐	钤
+鈑	钣
+鈒	钑
+鈔	钞
+鈕	钮
+鈞	钧
+鈣	钙
+鈥	钬
+鈦	钛
+鈧	钪
+鈮	铌
+鈰	铈
+鈳	钶
+鈴	铃
+鈷	钴
+鈸	钹
+鈹	铍
+鈺	钰
+鈽	钸
+鈾	铀
+鈿	钿
+鉀	钾
+鉈	铊
+鉉	铉
+鉋	铇
+鉍	铋
+鉑	铂
+鉕	钷
+鉚	铆
+鉛	铅
+鉞	钺
+鉦	钲
+鉬	钼
+鉭	钽
+鉶	铏
+鉸	铰
+鉺	铒
+鉻	铬
+鉿	铪
+銀	银
+銃	铳
+銅	铜
+銍	铚
+銑	铣
+銓	铨
+銖	铢
+銘	铭
+銚	铫
+銛	铦
+銜	衔
+銠	铑
+銣	铷
+銥	铱
+銦	铟
+銨	铵
+銩	铥
+銪	铕
+銫	铯
+銬	铐
+銱	铞
+銷	销
+銻	锑
+銼	锉
+鋃	锒
+鋅	锌
+鋇	钡
+鋌	铤
+鋏	铗
+鋒	锋
+鋙	铻
+鋝	锊
+鋟	锓
+鋣	铘
+鋤	锄
+鋥	锃
+鋦	锔
+鋨	锇
+鋩	铓
+鋮	铖
+鋯	锆
+鋰	锂
+鋱	铽
+鋶	锍
+鋸	锯
+鋼	钢
+錁	锞
+錆	锖
+錇	锫
+錈	锩
+錏	铔
+錐	锥
+錒	锕
+錕	锟
+錙	锱
+錚	铮
+錛	锛
+錟	锬
+錠	锭
+錡	锜
+錢	钱
+錦	锦
+錨	锚
+錩	锠
+錫	锡
+錮	锢
+錯	错
+錳	锰
+錸	铼
+鍀	锝
+鍃	锪
+鍆	钔
+鍇	锴
+鍈	锳
+鍋	锅
+鍍	镀
+鍔	锷
+鍘	铡
+鍚	钖
+鍛	锻
+鍠	锽
+鍤	锸
+鍥	锲
+鍩	锘
+鍬	锹
+鍰	锾
+鍵	键
+鍶	锶
+鍺	锗
+鎂	镁
+鎄	锿
+鎇	镅
+鎊	镑
+鎖	锁
+鎘	镉
+鎛	镈
+鎡	镃
+鎢	钨
+鎣	蓥
+鎦	镏
+鎧	铠
+鎩	铩
+鎪	锼
+鎬	镐
+鎮	镇
+鎰	镒
+鎲	镋
+鎳	镍
+鎵	镓
+鎿	镎
+鏃	镞
+鏇	镟
+鏌	镆
+鏍	镙
+鏐	镠
+鏑	镝
+鏗	铿
+鏘	锵
+鏜	镗
+鏝	镘
+鏞	镛
+鏡	镜
+鏢	镖
+鏤	镂
+鏨	錾
+鏰	镚
+鏵	铧
+鏷	镤
+鏹	镪
+鐃	铙
+鐋	铴
+鐐	镣
+鐒	铹
+鐓	镦
+鐔	镡
+鐙	镫
+鐠	镨
+鐦	锎
+鐧	锏
+鐨	镄
+鐮	镰
+鐲	镯
+鐳	镭
+鐵	铁
+鐶	镮
+鐸	铎
+鐺	铛
+鐿	镱
+鑄	铸
+鑊	镬
+鑌	镔
+鑔	镲
+鑕	锧
+鑞	镴
+鑠	铄
+鑣	镳
+鑥	镥
+鑭	镧
+鑰	钥
+鑱	镵
+鑲	镶
+鑷	镊
+鑹	镩
+鑼	锣
+鑽	钻
+鑾	銮
+鑿	凿
+長	长
+門	门
+閂	闩
+閃	闪
+閆	闫
+閈	闬
+閉	闭
+開	开
+閌	闶
+閎	闳
+閏	闰
+間	间
+閔	闵
+閘	闸
+閡	阂
+閣	阁
+閥	阀
+閨	闺
+閩	闽
+閫	阃
+閬	阆
+閭	闾
+閶	阊
+閹	阉
+閻	阎
+閼	阏
+閽	阍
+閾	阈
+閿	阌
+闃	阒
+闈	闱
+闊	阔
+闋	阕
+闌	阑
+闍	阇
+闐	阗
+闒	阘
+闓	闿
+闔	阖
+闕	阙
+闖	闯
+闞	阚
+闠	阓
+闡	阐
+闤	阛
+闥	闼
+阪	坂
+陘	陉
+陝	陕
+陣	阵
+陰	阴
+陳	陈
+陸	陆
+陽	阳
+隉	陧
+隊	队
+階	阶
+隕	陨
+際	际
+隨	随
+險	险
+隱	隐
+隴	陇
+隸	隶
+雋	隽
+雖	虽
+雙	双
+雛	雏
+雜	杂
+離	离
+難	难
+電	电
+霢	霡
+霧	雾
+霽	霁
+靂	雳
+靄	霭
+靈	灵
+靚	靓
+靜	静
+靨	靥
+鞀	鼗
+鞏	巩
+鞽	鞒
+韃	鞑
+韉	鞯
+韋	韦
+韌	韧
+韍	韨
+韓	韩
+韙	韪
+韜	韬
+韞	韫
+韻	韵
+響	响
+頁	页
+頂	顶
+頃	顷
+項	项
+順	顺
+頇	顸
+頊	顼
+頌	颂
+頎	颀
+頏	颃
+預	预
+頑	顽
+頒	颁
+頓	顿
+頗	颇
+領	领
+頜	颌
+頡	颉
+頤	颐
+頦	颏
+頭	头
+頮	颒
+頰	颊
+頲	颋
+頴	颕
+頷	颔
+頸	颈
+頻	频
+顆	颗
+題	题
+額	额
+顎	颚
+顒	颙
+顓	颛
+顙	颡
+顛	颠
+類	类
+顢	颟
+顥	颢
+顧	顾
+顫	颤
+顬	颥
+顯	显
+顰	颦
+顱	颅
+顳	颞
+顴	颧
+風	风
+颭	飐
+颮	飑
+颯	飒
+颶	飓
+颸	飔
+颻	飖
+颼	飕
+飀	飗
+飄	飘
+飆	飙
+飈	飚
+飛	飞
+飠	饣
+飣	饤
+飥	饦
+飩	饨
+飪	饪
+飫	饫
+飭	饬
+飯	饭
+飲	饮
+飴	饴
+飼	饲
+飽	饱
+飾	饰
+飿	饳
+餃	饺
+餄	饸
+餅	饼
+餉	饷
+養	养
+餌	饵
+餎	饹
+餏	饻
+餑	饽
+餒	馁
+餓	饿
+餕	馂
+餖	饾
+餛	馄
+餜	馃
+餞	饯
+餡	馅
+館	馆
+餱	糇
+餳	饧
+餶	馉
+餷	馇
+餺	馎
+餼	饩
+餾	馏
+餿	馊
+饁	馌
+饃	馍
+饅	馒
+饈	馐
+饉	馑
+饊	馓
+饌	馔
+饒	饶
+饗	飨
+饜	餍
+饞	馋
+饢	馕
+馬	马
+馭	驭
+馮	冯
+馱	驮
+馳	驰
+馴	驯
+馹	驲
+駁	驳
+駐	驻
+駑	驽
+駒	驹
+駔	驵
+駕	驾
+駘	骀
+駙	驸
+駛	驶
+駝	驼
+駟	驷
+駢	骈
+駭	骇
+駰	骃
+駱	骆
+駸	骎
+駿	骏
+騁	骋
+騂	骍
+騅	骓
+騌	骔
+騍	骒
+騎	骑
+騏	骐
+騖	骛
+騙	骗
+騤	骙
+騧	䯄
+騫	骞
+騭	骘
+騮	骝
+騰	腾
+騶	驺
+騷	骚
+騸	骟
+騾	骡
+驀	蓦
+驁	骜
+驂	骖
+驃	骠
+驄	骢
+驅	驱
+驊	骅
+驌	骕
+驍	骁
+驏	骣
+驕	骄
+驗	验
+驚	惊
+驛	驿
+驟	骤
+驢	驴
+驤	骧
+驥	骥
+驦	骦
+驪	骊
+驫	骉
+骯	肮
+髏	髅
+體	体
+髕	髌
+髖	髋
+鬢	鬓
+鬧	闹
+鬩	阋
+鬮	阄
+魎	魉
+魘	魇
+魚	鱼
+魛	鱽
+魢	鱾
+魨	鲀
+魯	鲁
+魴	鲂
+魷	鱿
+魺	鲄
+鮁	鲅
+鮃	鲆
+鮊	鲌
+鮋	鲉
+鮍	鲏
+鮐	鲐
+鮑	鲍
+鮒	鲋
+鮓	鲊
+鮚	鲒
+鮜	鲘
+鮞	鲕
+鮦	鲖
+鮪	鲔
+鮫	鲛
+鮭	鲑
+鮮	鲜
+鮳	鲓
+鮶	鲪
+鮺	鲝
+鯀	鲧
+鯁	鲠
+鯇	鲩
+鯉	鲤
+鯊	鲨
+鯒	鲬
+鯔	鲻
+鯕	鲯
+鯖	鲭
+鯛	鲷
+鯝	鲴
+鯡	鲱
+鯢	鲵
+鯤	鲲
+鯧	鲳
+鯨	鲸
+鯪	鲮
+鯫	鲰
+鯰	鲶
+鯴	鲺
+鯷	鳀
+鯽	鲫
+鯿	鳊
+鰁	鳈
+鰂	鲗
+鰃	鳂
+鰈	鲽
+鰉	鳇
+鰍	鳅
+鰏	鲾
+鰒	鳆
+鰓	鳃
+鰜	鳒
+鰟	鳑
+鰠	鳋
+鰣	鲥
+鰥	鳏
+鰨	鳎
+鰩	鳐
+鰭	鳍
+鰮	鳁
+鰱	鲢
+鰳	鳓
+鰵	鳘
+鰷	鲦
+鰹	鲣
+鰺	鲹
+鰻	鳗
+鰼	鳛
+鰾	鳔
+鱂	鳉
+鱅	鳙
+鱈	鳕
+鱒	鳟
+鱔	鳝
+鱖	鳜
+鱗	鳞
+鱘	鲟
+鱝	鲼
+鱟	鲎
+鱠	鲙
+鱣	鳣
+鱤	鳡
+鱧	鳢
+鱨	鲿
+鱭	鲚
+鱯	鳠
+鱸	鲈
+鱺	鲡
+鳥	鸟
+鳩	鸠
+鳲	鸤
+鳳	凤
+鳴	鸣
+鳶	鸢
+鳾	䴓
+鴆	鸩
+鴇	鸨
+鴉	鸦
+鴒	鸰
+鴕	鸵
+鴛	鸳
+鴝	鸲
+鴞	鸮
+鴟	鸱
+鴣	鸪
+鴦	鸯
+鴨	鸭
+鴯	鸸
+鴰	鸹
+鴴	鸻
+鴷	䴕
+鴻	鸿
+鴿	鸽
+鵁	䴔
+鵂	鸺
+鵃	鸼
+鵐	鹀
+鵑	鹃
+鵒	鹆
+鵓	鹁
+鵜	鹈
+鵝	鹅
+鵠	鹄
+鵡	鹉
+鵪	鹌
+鵬	鹏
+鵮	鹐
+鵯	鹎
+鵲	鹊
+鵷	鹓
+鵾	鹍
+鶄	䴖
+鶇	鸫
+鶉	鹑
+鶊	鹒
+鶓	鹋
+鶖	鹙
+鶘	鹕
+鶚	鹗
+鶡	鹖
+鶥	鹛
+鶩	鹜
+鶪	䴗
+鶬	鸧
+鶯	莺
+鶲	鹟
+鶴	鹤
+鶹	鹠
+鶺	鹡
+鶻	鹘
+鶼	鹣
+鷁	鹢
+鷂	鹞
+鷈	䴘
+鷊	鹝
+鷓	鹧
+鷖	鹥
+鷗	鸥
+鷙	鸷
+鷚	鹨
+鷥	鸶
+鷦	鹪
+鷫	鹔
+鷯	鹩
+鷲	鹫
+鷳	鹇
+鷸	鹬
+鷹	鹰
+鷺	鹭
+鷽	鸴
+鷿	䴙
+鸂	㶉
+鸇	鹯
+鸌	鹱
+鸏	鹲
+鸕	鸬
+鸘	鹴
+鸚	鹦
+鸛	鹳
+鸝	鹂
+鸞	鸾
+鹺	鹾
+鹼	碱
+鹽	盐
+麗	丽
+麥	麦
+麩	麸
+黃	黄
+黌	黉
+點	点
+黨	党
+黲	黪
+黶	黡
+黷	黩
+黽	黾
+黿	鼋
+鼉	鼍
+鼴	鼹
+齊	齐
+齋	斋
+齏	齑
+齒	齿
+齔	龀
+齕	龁
+齗	龂
+齙	龅
+齜	龇
+齟	龃
+齠	龆
+齡	龄
+齦	龈
+齪	龊
+齬	龉
+齲	龋
+齶	腭
+齷	龌
+龍	龙
+龎	厐
+龐	庞
+龔	龚
+龕	龛
+龜	龟
+「	“
+」	”
+`
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -8,15 +9,53 @@ import (
 )
 
 const (
-	spotifyBusName  = "org.mpris.MediaPlayer2.spotify"
-	mprisPath       = "/org/mpris/MediaPlayer2"
-	playerInterface = "org.mpris.MediaPlayer2.Player"
+	mprisPrefix         = "org.mpris.MediaPlayer2."
+	mprisPath           = "/org/mpris/MediaPlayer2"
+	mprisInterface      = "org.mpris.MediaPlayer2"
+	playerInterface     = "org.mpris.MediaPlayer2.Player"
+	trackListInterface  = "org.mpris.MediaPlayer2.TrackList"
+	propertiesInterface = "org.freedesktop.DBus.Properties"
 )
 
-var conn *dbus.Conn
+// MPRIS bus name of the player to talk to, can be changed with --player
+var playerBusName = mprisPrefix + "spotify"
+
+// Player is everything this program needs from an MPRIS player. The default
+// implementation talks to the session bus, but anything else (e.g. the fake
+// player, or a stub in tests) can be plugged in by assigning to `player`.
+type Player interface {
+	// get a property of the given interface
+	GetProperty(iface, name string) (dbus.Variant, error)
+	// set a property of the given interface
+	SetProperty(iface, name string, value any) error
+	// call a method (full name including interface) and return the reply body
+	Call(method string, args ...any) ([]any, error)
+}
+
+type dbusPlayer struct {
+	obj dbus.BusObject
+}
+
+func (p *dbusPlayer) GetProperty(iface, name string) (dbus.Variant, error) {
+	return p.obj.GetProperty(iface + "." + name)
+}
+
+func (p *dbusPlayer) SetProperty(iface, name string, value any) error {
+	return p.obj.SetProperty(iface+"."+name, dbus.MakeVariant(value))
+}
+
+func (p *dbusPlayer) Call(method string, args ...any) ([]any, error) {
+	call := p.obj.Call(method, 0, args...)
+	return call.Body, call.Err
+}
+
+var (
+	conn   *dbus.Conn
+	player Player
+)
 
 func initDBus() error {
-	if conn != nil {
+	if player != nil {
 		return nil
 	}
 
@@ -25,6 +64,7 @@ func initDBus() error {
 	if err != nil {
 		return fmt.Errorf("failed to connect to session bus: %v", err)
 	}
+	player = &dbusPlayer{obj: conn.Object(playerBusName, mprisPath)}
 	return nil
 }
 
@@ -33,19 +73,32 @@ func closeDBus() {
 		conn.Close()
 		conn = nil
 	}
+	player = nil
 }
 
-func getMetadata[T any](key string) (T, error) {
-	var zero T // default value
+func getPlayerProperty[T any](name string) (T, error) {
+	var zero T
 
 	if err := initDBus(); err != nil {
 		return zero, err
 	}
 
-	obj := conn.Object(spotifyBusName, mprisPath)
+	value, err := player.GetProperty(playerInterface, name)
+	if err != nil {
+		return zero, err
+	}
+
+	var result T
+	if err := value.Store(&result); err != nil {
+		return zero, fmt.Errorf("error storing value of property %s: %v", name, err)
+	}
+	return result, nil
+}
+
+func getMetadata[T any](key string) (T, error) {
+	var zero T // default value
 
-	var metadata map[string]dbus.Variant
-	err := obj.Call("org.freedesktop.DBus.Properties.Get", 0, playerInterface, "Metadata").Store(&metadata)
+	metadata, err := getPlayerProperty[map[string]dbus.Variant]("Metadata")
 	if err != nil {
 		return zero, fmt.Errorf("error getting metadata: %v", err)
 	}
@@ -77,14 +130,7 @@ func getTrackID() (string, error) {
 }
 
 func getPosition() (int, error) {
-	if err := initDBus(); err != nil {
-		return -1, err
-	}
-
-	obj := conn.Object(spotifyBusName, mprisPath)
-
-	var position uint64
-	err := obj.Call("org.freedesktop.DBus.Properties.Get", 0, playerInterface, "Position").Store(&position)
+	position, err := getPlayerProperty[int64]("Position")
 	if err != nil {
 		return -1, fmt.Errorf("error getting position: %v", err)
 	}
@@ -144,36 +190,198 @@ func setPosition(position int) error {
 		return err
 	}
 
-	obj := conn.Object(spotifyBusName, mprisPath)
-
 	fullTrackID, err := getMetadata[string]("mpris:trackid")
 	if err != nil {
 		return fmt.Errorf("error getting track ID: %v", err)
 	}
 
-	positionMicroseconds := int64(position * 1000) // Convert milliseconds to microseconds and use int64
+	positionMicroseconds := int64(position) * 1000 // Convert milliseconds to microseconds and use int64
 
 	// Use the full track ID as object path
-	call := obj.Call(playerInterface+".SetPosition", 0, dbus.ObjectPath(fullTrackID), positionMicroseconds)
-	if call.Err != nil {
-		return fmt.Errorf("error setting position: %v", call.Err)
+	if _, err := player.Call(playerInterface+".SetPosition", dbus.ObjectPath(fullTrackID), positionMicroseconds); err != nil {
+		return fmt.Errorf("error setting position: %v", err)
 	}
 
 	return nil
 }
 
 func getPlayingStatus() (bool, error) {
+	status, err := getPlayerProperty[string]("PlaybackStatus")
+	if err != nil {
+		return false, fmt.Errorf("error getting playback status: %v", err)
+	}
+
+	return status == "Playing", nil
+}
+
+func setPlayerProperty(name string, value any) error {
 	if err := initDBus(); err != nil {
-		return false, err
+		return err
+	}
+	if err := player.SetProperty(playerInterface, name, value); err != nil {
+		return fmt.Errorf("error setting %s: %v", name, err)
+	}
+	return nil
+}
+
+// call one of the parameterless player methods, e.g. Play, Pause, Next
+func callPlayer(method string, args ...any) error {
+	if err := initDBus(); err != nil {
+		return err
+	}
+	if _, err := player.Call(playerInterface+"."+method, args...); err != nil {
+		return fmt.Errorf("error calling %s: %v", method, err)
+	}
+	return nil
+}
+
+// seek relative to the current position (in ms)
+func seek(offset int) error {
+	return callPlayer("Seek", int64(offset)*1000)
+}
+
+func getPlaybackStatus() (string, error) {
+	status, err := getPlayerProperty[string]("PlaybackStatus")
+	if err != nil {
+		return "", fmt.Errorf("error getting playback status: %v", err)
 	}
+	return status, nil
+}
 
-	obj := conn.Object(spotifyBusName, mprisPath)
+func getVolume() (float64, error) {
+	volume, err := getPlayerProperty[float64]("Volume")
+	if err != nil {
+		return 0, fmt.Errorf("error getting volume: %v", err)
+	}
+	return volume, nil
+}
+
+func setVolume(volume float64) error {
+	return setPlayerProperty("Volume", max(volume, 0))
+}
 
-	var status string
-	err := obj.Call("org.freedesktop.DBus.Properties.Get", 0, playerInterface, "PlaybackStatus").Store(&status)
+func getShuffle() (bool, error) {
+	shuffle, err := getPlayerProperty[bool]("Shuffle")
 	if err != nil {
-		return false, fmt.Errorf("error getting playback status: %v", err)
+		return false, fmt.Errorf("error getting shuffle: %v", err)
 	}
+	return shuffle, nil
+}
 
-	return status == "Playing", nil
+func setShuffle(shuffle bool) error {
+	return setPlayerProperty("Shuffle", shuffle)
+}
+
+// one of "None", "Track" or "Playlist"
+func getLoopStatus() (string, error) {
+	loop, err := getPlayerProperty[string]("LoopStatus")
+	if err != nil {
+		return "", fmt.Errorf("error getting loop status: %v", err)
+	}
+	return loop, nil
+}
+
+func setLoopStatus(loop string) error {
+	return setPlayerProperty("LoopStatus", loop)
+}
+
+// TrackMetadata is the subset of the MPRIS metadata this program cares about
+type TrackMetadata struct {
+	MprisID string // mpris:trackid as reported by the player
+	Artist  string
+	Title   string
+	Album   string
+	Length  int    // in ms
+	ArtUrl  string // mpris:artUrl, may be empty
+}
+
+// reads all metadata of the current track at once
+func getTrackMetadata() (*TrackMetadata, error) {
+	metadata, err := getPlayerProperty[map[string]dbus.Variant]("Metadata")
+	if err != nil {
+		return nil, fmt.Errorf("error getting metadata: %v", err)
+	}
+	return parseTrackMetadata(metadata)
+}
+
+func parseTrackMetadata(metadata map[string]dbus.Variant) (*TrackMetadata, error) {
+	get := func(key string, dest any) {
+		if value, ok := metadata[key]; ok {
+			value.Store(dest)
+		}
+	}
+	ret := &TrackMetadata{}
+	var artists []string
+	var length uint64
+	get("mpris:trackid", &ret.MprisID)
+	get("xesam:artist", &artists)
+	get("xesam:title", &ret.Title)
+	get("xesam:album", &ret.Album)
+	get("mpris:length", &length)
+	get("mpris:artUrl", &ret.ArtUrl)
+	if ret.MprisID == "" {
+		return nil, fmt.Errorf("key mpris:trackid not found in metadata")
+	}
+	ret.Artist = strings.Join(artists, ", ")
+	ret.Length = int(length / 1000) // Convert microseconds to milliseconds
+	return ret, nil
+}
+
+var errNoTrackList = errors.New("player has no track list")
+
+// metadata of the tracks after the current one, if the player implements
+// the optional TrackList interface
+func getUpcomingTracks(limit int) ([]*TrackMetadata, error) {
+	if err := initDBus(); err != nil {
+		return nil, err
+	}
+	hasTrackList, err := player.GetProperty(mprisInterface, "HasTrackList")
+	if err != nil {
+		return nil, fmt.Errorf("error getting HasTrackList: %w", err)
+	}
+	if has, _ := hasTrackList.Value().(bool); !has {
+		return nil, errNoTrackList
+	}
+	tracksVar, err := player.GetProperty(trackListInterface, "Tracks")
+	if err != nil {
+		return nil, fmt.Errorf("error getting track list: %w", err)
+	}
+	var tracks []dbus.ObjectPath
+	if err := tracksVar.Store(&tracks); err != nil {
+		return nil, fmt.Errorf("error storing track list: %v", err)
+	}
+	current, err := getMetadata[string]("mpris:trackid")
+	if err != nil {
+		return nil, err
+	}
+	// only the tracks after the current one
+	for i, track := range tracks {
+		if string(track) == current {
+			tracks = tracks[i+1:]
+			break
+		}
+	}
+	tracks = tracks[:min(len(tracks), limit)]
+	if len(tracks) == 0 {
+		return nil, nil
+	}
+
+	body, err := player.Call(trackListInterface+".GetTracksMetadata", tracks)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tracks metadata: %w", err)
+	}
+	var metadata []map[string]dbus.Variant
+	if len(body) == 0 {
+		return nil, fmt.Errorf("empty reply from GetTracksMetadata")
+	}
+	if err := dbus.Store(body[:1], &metadata); err != nil {
+		return nil, fmt.Errorf("error storing tracks metadata: %v", err)
+	}
+	ret := make([]*TrackMetadata, 0, len(metadata))
+	for _, m := range metadata {
+		if meta, err := parseTrackMetadata(m); err == nil {
+			ret = append(ret, meta)
+		}
+	}
+	return ret, nil
 }
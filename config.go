@@ -8,6 +8,52 @@ var (
 	RETRY_INTERVAL_SEC       = 1
 	RETRY_TIMES              = 3
 	MIN_LISTEN_INTERVAL_MS   = 50
+	RETRY_AFTER_DEFAULT_SEC  = 5 // when a 429 response has no Retry-After header
+
+	// minimum time between two requests to the same provider
+	SPOTIFY_REQUEST_INTERVAL_MS = 500
+	LRCLIB_REQUEST_INTERVAL_MS  = 250
+
+	PREFETCH_JOBS  = 2 // concurrent fetches while prefetching
+	PREFETCH_AHEAD = 3 // upcoming tracks prefetched by listen
+
+	SYNC_EDIT_REPLAY_LEAD_MS = 3000 // sync-edit replays lines from this long before them
+
+	// a track jumping back to within PLAY_RESTART_MS of its start after
+	// playing for at least PLAY_MIN_MS counts as played again, e.g. on repeat
+	PLAY_RESTART_MS = 3000
+	PLAY_MIN_MS     = 30000
+
+	// the event log is rotated when it reaches this size, keeping this many old logs
+	EVENT_LOG_MAX_SIZE = int64(4 << 20)
+	EVENT_LOG_KEEP     = 3
+
+	STREAM_RECONNECT_INTERVAL_MS = 500 // how often a streamed named pipe is checked for a new reader
+	STREAM_WRITE_TIMEOUT_MS      = 100 // a message is dropped if the reader doesn't take it in time
+	STREAM_QUEUE_SIZE            = 64  // messages waiting to be streamed, further ones are dropped
+
+	// body of the notification of each state, see Notifier. {artist},
+	// {title}, {album} and {line} (the first line of the lyrics) are replaced.
+	NOTIFY_BODIES = map[string]string{
+		NOTIFY_FOUND:     "{artist}\n{line}",
+		NOTIFY_UNSYNCED:  "{artist}\nLyrics unsynchronized",
+		NOTIFY_NOT_FOUND: "{artist}\nNo lyrics found",
+	}
+	NOTIFY_TIMEOUT_MS = -1 // -1 leaves it to the notification daemon
+
+	// LibreTranslate compatible endpoint used by --secondary translate
+	TRANSLATE_URL    = "http://localhost:5000/translate"
+	TRANSLATE_TARGET = "en"
+
+	// post-processing steps applied to fetched lyrics, see postProcessSteps
+	POSTPROCESS = "none"
+	// lines removed by the credits step, matched against the trimmed line
+	CREDIT_PATTERNS = []string{
+		`^(作词|作詞|作曲|编曲|編曲|词|詞|曲|制作人|製作人|监制|監製|出品|发行|發行|混音|缩混|縮混|录音|錄音|和声|和聲|母带|母帶|配唱|制作|製作|吉他|贝斯|貝斯|鼓|键盘|鍵盤|弦乐|弦樂|企划|企劃|统筹|統籌|OP|SP|作詞者|作曲者|編曲者)\s*[:：]`,
+		`(?i)^(lyrics|lyricist|composer|arranger|producer|written|composed|arranged|produced|mixed|mastered|recorded)( by)?\s*[:：]`,
+		`(?i)^(written|composed|arranged|produced|mixed|mastered) by `,
+		`著作权|著作權|未经许可|未經許可`, // copyright notices of translations
+	}
 
 	TOKEN_URL       = "https://open.spotify.com/api/token"
 	LYRICS_URL      = "https://spclient.wg.spotify.com/color-lyrics/v2/track/"
@@ -18,6 +64,10 @@ var (
 	USER_AGENT        = "Mozilla/5.0 (X11; Linux x86_64; rv:143.0) Gecko/20100101 Firefox/143.0" // some random UA from my current browser :)
 	USER_AGENT_HONEST = "spotify-lyrics (https://github.com/Uyanide/Spotify_Lyrics)"
 
-	LRCLIB_API_URL = "https://lrclib.net/api/get"
-	FETCH_TIMEOUT  = 30 * time.Second
+	SPOTIFY_WEB_API_URL = "https://api.spotify.com/v1/"
+
+	LRCLIB_API_URL        = "https://lrclib.net/api/get"
+	LRCLIB_SEARCH_API_URL = "https://lrclib.net/api/search"
+	SPOTIFY_SEARCH_LIMIT  = 5 // lyrics are fetched for every result, so keep it small
+	FETCH_TIMEOUT         = 30 * time.Second
 )
@@ -6,33 +6,97 @@ import (
 	"strings"
 )
 
+// Display renders a window of `before` lines, the current line and `after`
+// lines. Every frame has the same number of rows so that the output does
+// not jump around.
 type Display struct {
-	numLines   int
-	lines      []string
+	before     int
+	after      int
+	width      int    // in terminal columns, 0 for unlimited
+	wrap       int    // rows per line, lines longer than width are wrapped into that many rows
+	marker     string // prefix of the current line
+	secondary  bool   // reserve rows for the secondary text of every line
+	filter     *ProfanityFilter
+	template   string // see SetTemplate
+	title      string // of the current track, for templates
+	stream     string // STREAM_FRAMES or STREAM_EVENTS, "" to rewrite outputPath on every frame
+	writer     *streamWriter
 	outputPath string
-	tail       int
-	size       int
 	cls        bool
 }
 
-func NewDisplay(numLines int, outputPath string, cls bool) *Display {
-	if numLines < 1 {
-		log("Invalid number of lines, defaulting to 1")
-		numLines = 1
+func NewDisplay(before, after int, outputPath string, cls bool) *Display {
+	if before < 0 || after < 0 {
+		log("Invalid number of lines, defaulting to 0")
+		before, after = max(before, 0), max(after, 0)
 	}
 	return &Display{
-		numLines:   numLines,
-		lines:      make([]string, numLines),
+		before:     before,
+		after:      after,
+		wrap:       1,
 		outputPath: outputPath,
-		tail:       0,
-		size:       0,
 		cls:        cls,
 	}
 }
 
+// limits the width of every line, wrapping it into up to `wrap` rows
+func (d *Display) SetWidth(width, wrap int) {
+	d.width = max(width, 0)
+	d.wrap = max(wrap, 1)
+}
+
+// the marker is only shown if there is more than one line
+func (d *Display) SetMarker(marker string) {
+	d.marker = marker
+}
+
+// lines may then have secondary text after a newline, which is shown below
+// them in as many rows as the line itself
+func (d *Display) SetSecondary(secondary bool) {
+	d.secondary = secondary
+}
+
+// masks or drops lines when they are shown, nil to show them as they are
+func (d *Display) SetFilter(filter *ProfanityFilter) {
+	d.filter = filter
+}
+
+// formats every frame with a template instead of printing its rows: {lines}
+// is replaced with the rows, {line}, {secondary}, {prev} and {next} with the
+// text of the current and surrounding lines and {title} with the track
+// title. \n and \t are a newline and a tab.
+func (d *Display) SetTemplate(template string) {
+	d.template = strings.NewReplacer(`\n`, "\n", `\t`, "\t").Replace(template)
+}
+
+// streams frames or events to stdout or a named pipe (created if missing)
+// instead of rewriting the output file
+func (d *Display) SetStream(mode string) error {
+	if err := checkStream(mode); err != nil || mode == "" {
+		return err
+	}
+	writer, err := newStreamWriter(d.outputPath)
+	if err != nil {
+		return err
+	}
+	d.stream, d.writer = mode, writer
+	return nil
+}
+
+func (d *Display) SetTitle(title string) {
+	d.title = title
+	if d.stream == STREAM_EVENTS {
+		d.writer.SendHeader(streamEvent{Event: "track", Title: d.filter.Metadata(title)})
+	}
+}
+
 func (d *Display) Clear() {
-	d.tail = 0
-	d.size = 0
+	if d.stream != "" {
+		if d.stream == STREAM_EVENTS {
+			d.writer.Send(streamEvent{Event: "clear"})
+		}
+		return
+	}
 	if d.outputPath == "/dev/stdout" || d.outputPath == "/dev/stderr" {
 		// case terminal output, only clear if cls is true
 		if d.cls {
@@ -40,41 +104,134 @@ func (d *Display) Clear() {
 		}
 	} else {
 		// otherwise reset the file content
-		if err := os.WriteFile(d.outputPath, []byte{}, 0644); err != nil {
+		if err := writeOutputFile(d.outputPath, []byte{}); err != nil {
 			log(fmt.Sprintf("Error writing to output file: %v", err))
 		}
 	}
 }
 
-func (d *Display) AddLine(line string) {
-	d.lines[d.tail%d.numLines] = line
-	d.tail = (d.tail + 1) % d.numLines
-	if d.size < d.numLines {
-		d.size++
+// the rows of a frame centered around lines[current], current may be out of
+// range, e.g. -1 before the first line
+func (d *Display) frame(lines []string, current int) []string {
+	marker, padding := "", ""
+	if d.before+d.after > 0 {
+		marker = d.marker
+		padding = strings.Repeat(" ", stringWidth(marker))
 	}
+	width := d.width
+	if width > 0 {
+		width = max(width-stringWidth(marker), 1)
+	}
+
+	rowsPerLine := d.wrap
+	if d.secondary {
+		rowsPerLine *= 2
+	}
+	rows := make([]string, 0, (d.before+1+d.after)*rowsPerLine)
+	for i := current - d.before; i <= current+d.after; i++ {
+		line := ""
+		if i >= 0 && i < len(lines) {
+			line = lines[i]
+		}
+		prefix := padding
+		if i == current {
+			prefix = marker
+		}
+		line, secondary, _ := strings.Cut(line, "\n")
+		if d.filter != nil {
+			line, secondary, _ = d.filter.FilterLine(line, secondary)
+		}
+		wrapped := wrapWidth(line, width, d.wrap)
+		if d.secondary {
+			// padded to a fixed number of rows, so that the secondary text
+			// always starts in the same row
+			for len(wrapped) < d.wrap {
+				wrapped = append(wrapped, "")
+			}
+			wrapped = append(wrapped, wrapWidth(secondary, width, d.wrap)...)
+		}
+		for j := 0; j < rowsPerLine; j++ {
+			row := ""
+			if j < len(wrapped) {
+				row = wrapped[j]
+			}
+			if j > 0 {
+				prefix = padding
+			}
+			if row == "" {
+				rows = append(rows, "")
+			} else {
+				rows = append(rows, prefix+row)
+			}
+		}
+	}
+	return rows
 }
 
-func (d *Display) display() {
+func (d *Display) Show(lines []string, current int) {
+	switch d.stream {
+	case STREAM_FRAMES:
+		d.writer.Send(streamFrame{Text: strings.TrimSuffix(d.render(lines, current), "\n"), Rows: d.frame(lines, current)})
+		return
+	case STREAM_EVENTS:
+		words, secondary := d.lineAt(lines, current)
+		d.writer.Send(streamEvent{Event: "line", Index: &current, Text: words, Secondary: secondary})
+		return
+	}
 	builder := strings.Builder{}
 	if d.cls && (d.outputPath == "/dev/stdout" || d.outputPath == "/dev/stderr") {
 		builder.WriteString("\033[H\033[2J") // Clear screen
 	}
-	head := d.tail + d.numLines - d.size
-	// Fill empty lines
-	for i := 0; i < d.numLines-d.size; i++ {
-		builder.WriteString("\n")
+	builder.WriteString(d.render(lines, current))
+	if err := writeOutputFile(d.outputPath, []byte(builder.String())); err != nil {
+		log(fmt.Sprintf("Error writing to output file: %v", err))
 	}
-	for i := 0; i < d.size; i++ {
-		builder.WriteString(d.lines[(head+i)%d.numLines] + "\n")
+}
+
+// the rows of a frame, or the template filled in
+func (d *Display) render(lines []string, current int) string {
+	rows := d.frame(lines, current)
+	if d.template == "" {
+		return strings.Join(rows, "\n") + "\n"
 	}
-	if err := os.WriteFile(d.outputPath, []byte(builder.String()), 0644); err != nil {
-		log(fmt.Sprintf("Error writing to output file: %v", err))
+	words, secondary := d.lineAt(lines, current)
+	prev, _ := d.lineAt(lines, current-1)
+	next, _ := d.lineAt(lines, current+1)
+	return strings.NewReplacer(
+		"{lines}", strings.Join(rows, "\n"),
+		"{line}", words,
+		"{secondary}", secondary,
+		"{prev}", prev,
+		"{next}", next,
+		"{title}", d.filter.Metadata(d.title),
+	).Replace(d.template) + "\n"
+}
+
+// the filtered text of lines[i], empty if out of range
+func (d *Display) lineAt(lines []string, i int) (words, secondary string) {
+	if i < 0 || i >= len(lines) {
+		return "", ""
 	}
+	words, secondary, _ = strings.Cut(lines[i], "\n")
+	words, secondary, _ = d.filter.FilterLine(words, secondary)
+	return words, secondary
 }
 
 func (d *Display) SingleLine(line string) {
-	d.Clear()
-	d.AddLine(line)
+	d.Message("", line)
+}
+
+// shows a message like "No lyrics found" as the current line below the
+// title, if any
+func (d *Display) Message(title, msg string) {
+	switch {
+	case d.stream == STREAM_EVENTS:
+		d.writer.Send(streamEvent{Event: "message", Text: msg})
+	case title == "":
+		d.Show([]string{msg}, 0)
+	default:
+		d.Show([]string{title, msg}, 1)
+	}
 }
 
 func log(message string) {
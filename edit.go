@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// the editor to use, like git: $VISUAL, then $EDITOR, then vi
+func getEditor() string {
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		if editor := strings.TrimSpace(os.Getenv(env)); editor != "" {
+			return editor
+		}
+	}
+	return "vi"
+}
+
+func runEditor(path string) error {
+	// through the shell, so that $EDITOR may contain arguments like "code -w"
+	cmd := exec.Command("sh", "-c", getEditor()+` "$1"`, "sh", path)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	return cmd.Run()
+}
+
+// parses edited LRC content, returning all problems found
+func parseEditedLyrics(content string) (*LyricsData, []error) {
+	data := &LyricsData{}
+	errs := data.lrcDecode(strings.Split(content, "\n"))
+	if len(data.Lyrics) == 0 {
+		errs = append(errs, fmt.Errorf("no lyric lines found"))
+	}
+	// without a [sync:] tag, lyrics with times are synced
+	if !strings.Contains(content, "[sync:") {
+		for _, line := range data.Lyrics {
+			data.IsLineSynced = data.IsLineSynced || line.StartTimeMs > 0
+		}
+	}
+	// sorts synced lyrics and turns the lines lrcEncodeFile writes for the
+	// secondary text back into it
+	dedupeTimestamps(data)
+	return data, errs
+}
+
+// asks a yes/no question on stderr, defaulting to yes
+func confirm(question string) bool {
+	fmt.Fprintf(os.Stderr, "%s [Y/n] ", question)
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return false
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "" || answer == "y" || answer == "yes"
+}
+
+// opens the cache entry of key in the editor until it parses, then stores it
+// as user-edited lyrics. Entries without lyrics start from the metadata.
+func editLyrics(cacheDir, key string) error {
+	rec, err := NewCache(cacheDir).Load(key)
+	if err != nil {
+		return fmt.Errorf("no cache entry for track ID %s: %v", key, err)
+	}
+	data := rec.LyricsData()
+	if rec.State != "ok" {
+		data.IsError, data.Is404 = false, false
+		data.Lyrics = nil
+	}
+
+	tmp, err := os.CreateTemp("", "spotify-lyrics-"+key+"-*.lrc")
+	if err != nil {
+		return fmt.Errorf("error creating temp file: %v", err)
+	}
+	tmp.Close()
+	defer os.Remove(tmp.Name())
+	if err := data.lrcEncodeFile(tmp.Name()); err != nil {
+		return fmt.Errorf("error writing temp file: %v", err)
+	}
+	original, err := os.ReadFile(tmp.Name())
+	if err != nil {
+		return err
+	}
+
+	var edited *LyricsData
+	for {
+		if err := runEditor(tmp.Name()); err != nil {
+			return fmt.Errorf("error running editor %s: %v", getEditor(), err)
+		}
+		content, err := os.ReadFile(tmp.Name())
+		if err != nil {
+			return fmt.Errorf("error reading temp file: %v", err)
+		}
+		if string(content) == string(original) {
+			log("No changes")
+			return nil
+		}
+		var errs []error
+		if edited, errs = parseEditedLyrics(string(content)); len(errs) == 0 {
+			break
+		}
+		for _, err := range errs {
+			log(err.Error())
+		}
+		if !confirm("Edit again?") {
+			return fmt.Errorf("changes discarded")
+		}
+	}
+
+	edited.Provider = data.Provider
+	edited.Length = data.Length
+	if err := edited.storeUserEdited(cacheDir, key); err != nil {
+		return err
+	}
+	log(fmt.Sprintf("Saved %d lines as user-edited lyrics of %s", len(edited.Lyrics), key))
+	return nil
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+// lyrics written to the edit buffer parse back unchanged
+func TestParseEditedLyricsRoundTrip(t *testing.T) {
+	data := &LyricsData{
+		Title:        "Song",
+		Artist:       "Artist",
+		IsLineSynced: true,
+		Lyrics: []LyricLine{
+			{StartTimeMs: 1000, Words: "one", Secondary: "eins"},
+			{StartTimeMs: 3000, Words: "two"},
+			{StartTimeMs: 5000, Words: "three", Secondary: "drei"},
+		},
+	}
+	path := filepath.Join(t.TempDir(), "edit.lrc")
+	if err := data.lrcEncodeFile(path); err != nil {
+		t.Fatal(err)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edited, errs := parseEditedLyrics(string(content))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	if !edited.IsLineSynced || !slices.Equal(edited.Lyrics, data.Lyrics) {
+		t.Errorf("parsed %+v, want %+v", edited.Lyrics, data.Lyrics)
+	}
+}
@@ -0,0 +1,361 @@
+package main
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+// kinds of events
+const (
+	EVENT_PLAY  = "play"  // listen switched to a track
+	EVENT_FETCH = "fetch" // a provider was asked for lyrics
+)
+
+// outcomes of fetch events, besides the states synced, unsynced and 404
+const (
+	OUTCOME_ERROR    = "error"
+	OUTCOME_CANCELED = "canceled"
+)
+
+// Event is a line of the event log
+type Event struct {
+	Time      time.Time `json:"time"`
+	Type      string    `json:"type"`
+	Key       string    `json:"key"` // cache key of the track
+	Artist    string    `json:"artist,omitempty"`
+	Title     string    `json:"title,omitempty"`
+	Provider  string    `json:"provider,omitempty"`
+	Outcome   string    `json:"outcome,omitempty"`
+	LatencyMs int64     `json:"latency_ms,omitempty"` // of the request that decided the outcome
+	Attempts  int       `json:"attempts,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+func eventLogFile(cacheDir string) string {
+	return filepath.Join(cacheDir, "events.jsonl")
+}
+
+// appends an event to the log in cacheDir, which is rotated once it grows
+// larger than EVENT_LOG_MAX_SIZE. Errors are only logged.
+func recordEvent(cacheDir string, e Event) {
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	if err := appendEvent(eventLogFile(cacheDir), e); err != nil {
+		log(fmt.Sprintf("Error writing event log: %v", err))
+	}
+}
+
+func appendEvent(path string, e Event) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	// several processes may log at once, e.g. listen and prefetch
+	unlock, err := flockFile(path+".lock", true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if info, err := os.Stat(path); err == nil && info.Size()+int64(len(line)) >= EVENT_LOG_MAX_SIZE {
+		rotateEventLog(path)
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.Write(append(line, '\n'))
+	return err
+}
+
+// path.1 is the newest rotated log, the oldest beyond EVENT_LOG_KEEP are removed
+func rotateEventLog(path string) {
+	os.Remove(fmt.Sprintf("%s.%d", path, EVENT_LOG_KEEP))
+	for i := EVENT_LOG_KEEP - 1; i >= 1; i-- {
+		os.Rename(fmt.Sprintf("%s.%d", path, i), fmt.Sprintf("%s.%d", path, i+1))
+	}
+	os.Rename(path, path+".1")
+}
+
+// reads the events between since and until (zero for no limit) from the
+// current and the rotated logs, oldest first. Broken lines are skipped.
+func readEvents(cacheDir string, since, until time.Time) ([]Event, error) {
+	path := eventLogFile(cacheDir)
+	unlock, err := flockFile(path+".lock", false)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	var events []Event
+	for i := EVENT_LOG_KEEP; i >= 0; i-- {
+		name := path
+		if i > 0 {
+			name = fmt.Sprintf("%s.%d", path, i)
+		}
+		file, err := os.Open(name)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			var e Event
+			if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+				continue
+			}
+			if (since.IsZero() || !e.Time.Before(since)) && (until.IsZero() || e.Time.Before(until)) {
+				events = append(events, e)
+			}
+		}
+		err = scanner.Err()
+		file.Close()
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s: %v", name, err)
+		}
+	}
+	return events, nil
+}
+
+// records the outcome of asking provider for the lyrics of data, latency is
+// that of the last attempt
+func (data *LyricsData) recordFetch(ctx context.Context, cache *Cache, provider string, err error, latency time.Duration, attempts int) {
+	e := Event{
+		Type:      EVENT_FETCH,
+		Key:       data.TrackID,
+		Artist:    data.Artist,
+		Title:     data.Title,
+		Provider:  provider,
+		LatencyMs: latency.Milliseconds(),
+		Attempts:  attempts,
+	}
+	switch {
+	case ctx.Err() != nil:
+		e.Outcome = OUTCOME_CANCELED
+	case err == nil && data.IsLineSynced:
+		e.Outcome = "synced"
+	case err == nil:
+		e.Outcome = "unsynced"
+	case data.Is404:
+		e.Outcome = "404"
+	default:
+		e.Outcome, e.Error = OUTCOME_ERROR, err.Error()
+	}
+	recordEvent(cache.dir, e)
+}
+
+// parses the bounds of the stats date range: a date (2006-01-02), a date
+// and time (2006-01-02 15:04) or an age like "30d" before now. "" for none.
+func parseEventTime(s string, now time.Time) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range []string{time.DateOnly, "2006-01-02 15:04", time.RFC3339} {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	age, err := parseAge(s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q, expected a date like 2006-01-02 or an age like 30d", s)
+	}
+	return now.Add(-age), nil
+}
+
+// --- statistics ---
+
+type ProviderStats struct {
+	Provider     string  `json:"provider"`
+	Fetches      int     `json:"fetches"`
+	Synced       int     `json:"synced"`
+	Unsynced     int     `json:"unsynced"`
+	NotFound     int     `json:"not_found"`
+	Errors       int     `json:"errors"`
+	HitRate      float64 `json:"hit_rate"` // synced or unsynced of all fetches that were not canceled
+	AvgLatencyMs int64   `json:"avg_latency_ms"`
+}
+
+type CountStats struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+type MissStats struct {
+	Artist  string  `json:"artist"`
+	Tracks  int     `json:"tracks"`  // fetched tracks
+	Missing int     `json:"missing"` // of which providers had no lyrics (404)
+	Rate    float64 `json:"rate"`
+}
+
+// Stats summarizes the event log
+type Stats struct {
+	Since        time.Time       `json:"since,omitzero"` // as requested, zero for no limit
+	Until        time.Time       `json:"until,omitzero"`
+	Plays        int             `json:"plays"`
+	Fetches      int             `json:"fetches"`
+	AvgLatencyMs int64           `json:"avg_latency_ms"`
+	Providers    []ProviderStats `json:"providers"`
+	TopArtists   []CountStats    `json:"top_artists"`
+	TopTracks    []CountStats    `json:"top_tracks"`
+	MissingBy    []MissStats     `json:"404_artists"`
+}
+
+// computes the statistics of the events read for the period from since to
+// until, top lists have at most top entries
+func computeStats(events []Event, since, until time.Time, top int) *Stats {
+	s := &Stats{Since: since, Until: until}
+	providers := map[string]*ProviderStats{}
+	latency := map[string]int64{}
+	var totalLatency int64
+	artists := map[string]int{}
+	tracks := map[string]int{}
+	type trackResult struct {
+		artist   string
+		found    bool
+		notFound bool
+	}
+	fetched := map[string]*trackResult{}
+
+	for _, e := range events {
+		switch e.Type {
+		case EVENT_PLAY:
+			s.Plays++
+			artist := cmp.Or(e.Artist, "unknown")
+			artists[artist]++
+			tracks[artist+" - "+cmp.Or(e.Title, e.Key)]++
+		case EVENT_FETCH:
+			if e.Outcome == OUTCOME_CANCELED {
+				continue
+			}
+			s.Fetches++
+			p := providers[e.Provider]
+			if p == nil {
+				p = &ProviderStats{Provider: e.Provider}
+				providers[e.Provider] = p
+			}
+			p.Fetches++
+			switch e.Outcome {
+			case "synced":
+				p.Synced++
+			case "unsynced":
+				p.Unsynced++
+			case "404":
+				p.NotFound++
+			default:
+				p.Errors++
+			}
+			latency[e.Provider] += e.LatencyMs
+			totalLatency += e.LatencyMs
+
+			t := fetched[e.Key]
+			if t == nil {
+				t = &trackResult{artist: cmp.Or(e.Artist, "unknown")}
+				fetched[e.Key] = t
+			}
+			t.found = t.found || e.Outcome == "synced" || e.Outcome == "unsynced"
+			t.notFound = t.notFound || e.Outcome == "404"
+		}
+	}
+
+	if s.Fetches > 0 {
+		s.AvgLatencyMs = totalLatency / int64(s.Fetches)
+	}
+	for name, p := range providers {
+		p.HitRate = float64(p.Synced+p.Unsynced) / float64(p.Fetches)
+		p.AvgLatencyMs = latency[name] / int64(p.Fetches)
+		s.Providers = append(s.Providers, *p)
+	}
+	slices.SortFunc(s.Providers, func(a, b ProviderStats) int { return cmp.Compare(a.Provider, b.Provider) })
+	s.TopArtists = topCounts(artists, top)
+	s.TopTracks = topCounts(tracks, top)
+
+	misses := map[string]*MissStats{}
+	for _, t := range fetched {
+		m := misses[t.artist]
+		if m == nil {
+			m = &MissStats{Artist: t.artist}
+			misses[t.artist] = m
+		}
+		m.Tracks++
+		if !t.found && t.notFound {
+			m.Missing++
+		}
+	}
+	for _, m := range misses {
+		if m.Missing > 0 {
+			m.Rate = float64(m.Missing) / float64(m.Tracks)
+			s.MissingBy = append(s.MissingBy, *m)
+		}
+	}
+	slices.SortFunc(s.MissingBy, func(a, b MissStats) int {
+		return cmp.Or(cmp.Compare(b.Missing, a.Missing), cmp.Compare(b.Rate, a.Rate), cmp.Compare(a.Artist, b.Artist))
+	})
+	if len(s.MissingBy) > top {
+		s.MissingBy = s.MissingBy[:top]
+	}
+	return s
+}
+
+// the top entries of counts, most first
+func topCounts(counts map[string]int, top int) []CountStats {
+	result := make([]CountStats, 0, len(counts))
+	for name, count := range counts {
+		result = append(result, CountStats{name, count})
+	}
+	slices.SortFunc(result, func(a, b CountStats) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Name, b.Name))
+	})
+	if len(result) > top {
+		result = result[:top]
+	}
+	return result
+}
+
+func (s *Stats) Print() {
+	if s.Plays == 0 && s.Fetches == 0 {
+		fmt.Println("No events in this period")
+		return
+	}
+	bound := func(t time.Time, none string) string {
+		if t.IsZero() {
+			return none
+		}
+		return t.Format(time.DateTime)
+	}
+	fmt.Printf("Period: %s to %s\n", bound(s.Since, "the start of the log"), bound(s.Until, "now"))
+	fmt.Printf("Plays: %d, fetches: %d, average latency: %dms\n", s.Plays, s.Fetches, s.AvgLatencyMs)
+	if len(s.Providers) > 0 {
+		fmt.Println("Providers:")
+		for _, p := range s.Providers {
+			fmt.Printf("  %-9s %5.1f%% hits of %d (synced %d, unsynced %d, 404 %d, error %d), average latency %dms\n",
+				p.Provider+":", p.HitRate*100, p.Fetches, p.Synced, p.Unsynced, p.NotFound, p.Errors, p.AvgLatencyMs)
+		}
+	}
+	printCounts := func(title string, counts []CountStats) {
+		if len(counts) == 0 {
+			return
+		}
+		fmt.Println(title)
+		for i, c := range counts {
+			fmt.Printf("  %2d. %s (%d)\n", i+1, c.Name, c.Count)
+		}
+	}
+	printCounts("Top artists:", s.TopArtists)
+	printCounts("Top tracks:", s.TopTracks)
+	if len(s.MissingBy) > 0 {
+		fmt.Println("Artists without lyrics:")
+		for i, m := range s.MissingBy {
+			fmt.Printf("  %2d. %s (%d of %d tracks)\n", i+1, m.Artist, m.Missing, m.Tracks)
+		}
+	}
+}
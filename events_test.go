@@ -0,0 +1,28 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+// the report covers the requested period, not the one of the events in it
+func TestComputeStatsPeriod(t *testing.T) {
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.Local)
+	until := time.Date(2026, 2, 1, 0, 0, 0, 0, time.Local)
+	events := []Event{
+		{Time: since.Add(48 * time.Hour), Type: EVENT_PLAY, Key: "a", Artist: "Artist", Title: "Song"},
+		{Time: since.Add(72 * time.Hour), Type: EVENT_FETCH, Key: "a", Artist: "Artist", Provider: "lrclib", Outcome: "synced", LatencyMs: 100},
+	}
+	s := computeStats(events, since, until, 5)
+	if !s.Since.Equal(since) || !s.Until.Equal(until) {
+		t.Errorf("period is %v to %v, want %v to %v", s.Since, s.Until, since, until)
+	}
+	if s.Plays != 1 || s.Fetches != 1 {
+		t.Errorf("%d plays and %d fetches, want 1 and 1", s.Plays, s.Fetches)
+	}
+
+	s = computeStats(events, time.Time{}, time.Time{}, 5)
+	if !s.Since.IsZero() || !s.Until.IsZero() {
+		t.Errorf("period is %v to %v without limits, want none", s.Since, s.Until)
+	}
+}
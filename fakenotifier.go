@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+)
+
+// A stub notification daemon, used to test notifications without a desktop.
+// Every notification it receives is printed to stdout as a line of JSON.
+
+type fakeNotification struct {
+	ID         uint32 `json:"id"`
+	ReplacesID uint32 `json:"replaces_id"`
+	AppName    string `json:"app_name"`
+	Icon       string `json:"icon"`
+	Summary    string `json:"summary"`
+	Body       string `json:"body"`
+	ImagePath  string `json:"image_path,omitempty"`
+	Timeout    int32  `json:"timeout"`
+}
+
+type FakeNotifier struct {
+	mu     sync.Mutex
+	nextID uint32
+	out    io.Writer // os.Stdout if nil
+}
+
+func (n *FakeNotifier) serve(c *dbus.Conn) error {
+	methods := map[string]any{
+		"Notify": func(appName string, replacesID uint32, icon, summary, body string, actions []string,
+			hints map[string]dbus.Variant, timeout int32) (uint32, *dbus.Error) {
+			n.mu.Lock()
+			defer n.mu.Unlock()
+			id := replacesID
+			if id == 0 {
+				n.nextID++
+				id = n.nextID
+			}
+			notification := fakeNotification{
+				ID:         id,
+				ReplacesID: replacesID,
+				AppName:    appName,
+				Icon:       icon,
+				Summary:    summary,
+				Body:       body,
+				Timeout:    timeout,
+			}
+			if v, ok := hints["image-path"]; ok {
+				v.Store(&notification.ImagePath)
+			}
+			line, _ := json.Marshal(notification)
+			out := n.out
+			if out == nil {
+				out = os.Stdout
+			}
+			fmt.Fprintln(out, string(line))
+			return id, nil
+		},
+		"CloseNotification": func(id uint32) *dbus.Error { return nil },
+		"GetCapabilities": func() ([]string, *dbus.Error) {
+			return []string{"body"}, nil
+		},
+		"GetServerInformation": func() (string, string, string, string, *dbus.Error) {
+			return "fake-notifier", "spotify-lyrics", "1.0", "1.2", nil
+		},
+	}
+	if err := c.ExportMethodTable(methods, notificationsPath, notificationsInterface); err != nil {
+		return fmt.Errorf("error exporting notifications interface: %v", err)
+	}
+	reply, err := c.RequestName(notificationsBusName, dbus.NameFlagDoNotQueue)
+	if err != nil {
+		return fmt.Errorf("error requesting bus name %s: %v", notificationsBusName, err)
+	}
+	if reply != dbus.RequestNameReplyPrimaryOwner {
+		return fmt.Errorf("bus name %s already taken", notificationsBusName)
+	}
+	return nil
+}
@@ -0,0 +1,641 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+)
+
+// A scriptable MPRIS player, used for integration tests and demos.
+// It can either be exported on a bus (see serve) or be used in-process
+// by assigning it to `player`, since it also implements Player.
+
+type fakeTrack struct {
+	ID     dbus.ObjectPath
+	Artist []string
+	Title  string
+	Album  string
+	ArtUrl string
+	Length int64 // in us
+}
+
+type FakePlayer struct {
+	mu      sync.Mutex
+	conn    *dbus.Conn // nil if not exported
+	tracks  []fakeTrack
+	current int
+	status  string
+	loop    string
+	shuffle bool
+	volume  float64
+	posBase int64     // position in us at posTime
+	posTime time.Time // only meaningful while playing
+}
+
+func NewFakePlayer() *FakePlayer {
+	return &FakePlayer{
+		status: "Stopped",
+		loop:   "None",
+		volume: 1.0,
+	}
+}
+
+func (p *FakePlayer) AddTrack(t fakeTrack) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.tracks = append(p.tracks, t)
+	if len(p.tracks) == 1 {
+		p.emitChanged(playerInterface, "Metadata")
+	}
+}
+
+// must be called with p.mu held
+func (p *FakePlayer) position() int64 {
+	if p.status != "Playing" {
+		return p.posBase
+	}
+	return p.posBase + time.Since(p.posTime).Microseconds()
+}
+
+// must be called with p.mu held
+func (p *FakePlayer) setPos(pos int64) {
+	if pos < 0 {
+		pos = 0
+	}
+	p.posBase = pos
+	p.posTime = time.Now()
+}
+
+// must be called with p.mu held
+func (p *FakePlayer) track() *fakeTrack {
+	if len(p.tracks) == 0 {
+		return nil
+	}
+	return &p.tracks[p.current]
+}
+
+// handles reaching the end of the current track, must be called with p.mu held
+func (p *FakePlayer) advance() {
+	t := p.track()
+	if t == nil || p.status != "Playing" || p.position() < t.Length {
+		return
+	}
+	switch {
+	case p.loop == "Track":
+		p.setPos(0)
+	case p.current+1 < len(p.tracks) || p.loop == "Playlist":
+		p.switchTrack((p.current + 1) % len(p.tracks))
+	default:
+		p.setPos(t.Length)
+		p.status = "Stopped"
+		p.emitChanged(playerInterface, "PlaybackStatus")
+	}
+}
+
+// must be called with p.mu held
+func (p *FakePlayer) switchTrack(idx int) {
+	p.current = idx
+	p.setPos(0)
+	p.emitChanged(playerInterface, "Metadata")
+}
+
+// must be called with p.mu held
+func (p *FakePlayer) setStatus(status string) {
+	if p.status == status {
+		return
+	}
+	pos := p.position()
+	p.status = status
+	if status == "Stopped" {
+		pos = 0
+	}
+	p.setPos(pos)
+	p.emitChanged(playerInterface, "PlaybackStatus")
+}
+
+// must be called with p.mu held
+func (p *FakePlayer) metadata() map[string]dbus.Variant {
+	t := p.track()
+	if t == nil {
+		return map[string]dbus.Variant{
+			"mpris:trackid": dbus.MakeVariant(dbus.ObjectPath("/org/mpris/MediaPlayer2/TrackList/NoTrack")),
+		}
+	}
+	return t.metadata()
+}
+
+func (t *fakeTrack) metadata() map[string]dbus.Variant {
+	ret := map[string]dbus.Variant{
+		"mpris:trackid": dbus.MakeVariant(t.ID),
+		"mpris:length":  dbus.MakeVariant(t.Length),
+		"xesam:artist":  dbus.MakeVariant(t.Artist),
+		"xesam:title":   dbus.MakeVariant(t.Title),
+		"xesam:album":   dbus.MakeVariant(t.Album),
+	}
+	if t.ArtUrl != "" {
+		ret["mpris:artUrl"] = dbus.MakeVariant(t.ArtUrl)
+	}
+	return ret
+}
+
+// must be called with p.mu held
+func (p *FakePlayer) properties(iface string) (map[string]dbus.Variant, error) {
+	switch iface {
+	case mprisInterface:
+		return map[string]dbus.Variant{
+			"Identity":            dbus.MakeVariant("Fake Player"),
+			"CanQuit":             dbus.MakeVariant(false),
+			"CanRaise":            dbus.MakeVariant(false),
+			"HasTrackList":        dbus.MakeVariant(true),
+			"SupportedUriSchemes": dbus.MakeVariant([]string{}),
+			"SupportedMimeTypes":  dbus.MakeVariant([]string{}),
+		}, nil
+	case playerInterface:
+		return map[string]dbus.Variant{
+			"PlaybackStatus": dbus.MakeVariant(p.status),
+			"LoopStatus":     dbus.MakeVariant(p.loop),
+			"Shuffle":        dbus.MakeVariant(p.shuffle),
+			"Volume":         dbus.MakeVariant(p.volume),
+			"Rate":           dbus.MakeVariant(1.0),
+			"MinimumRate":    dbus.MakeVariant(1.0),
+			"MaximumRate":    dbus.MakeVariant(1.0),
+			"Metadata":       dbus.MakeVariant(p.metadata()),
+			"Position":       dbus.MakeVariant(p.position()),
+			"CanGoNext":      dbus.MakeVariant(true),
+			"CanGoPrevious":  dbus.MakeVariant(true),
+			"CanPlay":        dbus.MakeVariant(true),
+			"CanPause":       dbus.MakeVariant(true),
+			"CanSeek":        dbus.MakeVariant(true),
+			"CanControl":     dbus.MakeVariant(true),
+		}, nil
+	case trackListInterface:
+		tracks := make([]dbus.ObjectPath, len(p.tracks))
+		for i, t := range p.tracks {
+			tracks[i] = t.ID
+		}
+		return map[string]dbus.Variant{
+			"Tracks":        dbus.MakeVariant(tracks),
+			"CanEditTracks": dbus.MakeVariant(false),
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown interface %s", iface)
+}
+
+// must be called with p.mu held
+func (p *FakePlayer) emitChanged(iface string, names ...string) {
+	if p.conn == nil {
+		return
+	}
+	props, err := p.properties(iface)
+	if err != nil {
+		return
+	}
+	changed := make(map[string]dbus.Variant, len(names))
+	for _, name := range names {
+		changed[name] = props[name]
+	}
+	p.conn.Emit(mprisPath, propertiesInterface+".PropertiesChanged", iface, changed, []string{})
+}
+
+// must be called with p.mu held
+func (p *FakePlayer) emitSeeked() {
+	if p.conn == nil {
+		return
+	}
+	p.conn.Emit(mprisPath, playerInterface+".Seeked", p.position())
+}
+
+// Player implementation, so that the fake player can be used in-process
+
+func (p *FakePlayer) GetProperty(iface, name string) (dbus.Variant, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.advance()
+	props, err := p.properties(iface)
+	if err != nil {
+		return dbus.Variant{}, err
+	}
+	value, ok := props[name]
+	if !ok {
+		return dbus.Variant{}, fmt.Errorf("unknown property %s.%s", iface, name)
+	}
+	return value, nil
+}
+
+func (p *FakePlayer) SetProperty(iface, name string, value any) error {
+	if v, ok := value.(dbus.Variant); ok {
+		value = v.Value()
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.advance()
+	if iface != playerInterface {
+		return fmt.Errorf("property %s.%s is read-only", iface, name)
+	}
+	var ok bool
+	switch name {
+	case "LoopStatus":
+		var loop string
+		if loop, ok = value.(string); ok {
+			if loop != "None" && loop != "Track" && loop != "Playlist" {
+				return fmt.Errorf("invalid loop status %s", loop)
+			}
+			p.loop = loop
+		}
+	case "Shuffle":
+		p.shuffle, ok = value.(bool)
+	case "Volume":
+		var volume float64
+		if volume, ok = value.(float64); ok {
+			p.volume = max(volume, 0)
+		}
+	default:
+		return fmt.Errorf("property %s.%s is read-only", iface, name)
+	}
+	if !ok {
+		return fmt.Errorf("invalid value type %T for property %s", value, name)
+	}
+	p.emitChanged(iface, name)
+	return nil
+}
+
+func (p *FakePlayer) Call(method string, args ...any) ([]any, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.advance()
+	if method == trackListInterface+".GetTracksMetadata" {
+		ids, ok := argAt[[]dbus.ObjectPath](args, 0)
+		if !ok {
+			return nil, fmt.Errorf("invalid arguments for GetTracksMetadata")
+		}
+		return []any{p.tracksMetadata(ids)}, nil
+	}
+	name, ok := strings.CutPrefix(method, playerInterface+".")
+	if !ok {
+		return nil, fmt.Errorf("unknown method %s", method)
+	}
+	switch name {
+	case "Play":
+		if p.track() != nil {
+			p.setStatus("Playing")
+		}
+	case "Pause":
+		if p.status == "Playing" {
+			p.setStatus("Paused")
+		}
+	case "PlayPause":
+		if p.status == "Playing" {
+			p.setStatus("Paused")
+		} else if p.track() != nil {
+			p.setStatus("Playing")
+		}
+	case "Stop":
+		p.setStatus("Stopped")
+	case "Next":
+		if len(p.tracks) > 0 && (p.current+1 < len(p.tracks) || p.loop == "Playlist") {
+			p.switchTrack((p.current + 1) % len(p.tracks))
+		}
+	case "Previous":
+		if p.position() > 3000000 || len(p.tracks) == 0 {
+			p.setPos(0)
+			p.emitSeeked()
+		} else {
+			p.switchTrack((p.current + len(p.tracks) - 1) % len(p.tracks))
+		}
+	case "Seek":
+		offset, ok := argAt[int64](args, 0)
+		if !ok {
+			return nil, fmt.Errorf("invalid arguments for %s", name)
+		}
+		t := p.track()
+		if t == nil {
+			return nil, nil
+		}
+		pos := p.position() + offset
+		if pos >= t.Length {
+			if p.current+1 < len(p.tracks) {
+				p.switchTrack(p.current + 1)
+			}
+			return nil, nil
+		}
+		p.setPos(pos)
+		p.emitSeeked()
+	case "SetPosition":
+		trackID, ok1 := argAt[dbus.ObjectPath](args, 0)
+		pos, ok2 := argAt[int64](args, 1)
+		if !ok1 || !ok2 {
+			return nil, fmt.Errorf("invalid arguments for %s", name)
+		}
+		t := p.track()
+		// as per spec, ignore stale track IDs and out of range positions
+		if t == nil || t.ID != trackID || pos < 0 || pos > t.Length {
+			return nil, nil
+		}
+		p.setPos(pos)
+		p.emitSeeked()
+	case "OpenUri":
+		return nil, fmt.Errorf("OpenUri is not supported")
+	default:
+		return nil, fmt.Errorf("unknown method %s", method)
+	}
+	return nil, nil
+}
+
+// metadata of the given tracks in the same order, unknown IDs are skipped,
+// must be called with p.mu held
+func (p *FakePlayer) tracksMetadata(ids []dbus.ObjectPath) []map[string]dbus.Variant {
+	ret := make([]map[string]dbus.Variant, 0, len(ids))
+	for _, id := range ids {
+		for i := range p.tracks {
+			if p.tracks[i].ID == id {
+				ret = append(ret, p.tracks[i].metadata())
+				break
+			}
+		}
+	}
+	return ret
+}
+
+func argAt[T any](args []any, i int) (T, bool) {
+	var zero T
+	if i >= len(args) {
+		return zero, false
+	}
+	v, ok := args[i].(T)
+	return v, ok
+}
+
+// serve exports the player on the given connection under the given bus name,
+// it returns once the name is acquired
+func (p *FakePlayer) serve(c *dbus.Conn, busName string) error {
+	p.mu.Lock()
+	p.conn = c
+	p.mu.Unlock()
+
+	toDBusErr := func(err error) *dbus.Error {
+		if err == nil {
+			return nil
+		}
+		return dbus.MakeFailedError(err)
+	}
+	call := func(method string) func() *dbus.Error {
+		return func() *dbus.Error {
+			_, err := p.Call(playerInterface + "." + method)
+			return toDBusErr(err)
+		}
+	}
+
+	playerMethods := map[string]any{
+		"Play":      call("Play"),
+		"Pause":     call("Pause"),
+		"PlayPause": call("PlayPause"),
+		"Stop":      call("Stop"),
+		"Next":      call("Next"),
+		"Previous":  call("Previous"),
+		"Seek": func(offset int64) *dbus.Error {
+			_, err := p.Call(playerInterface+".Seek", offset)
+			return toDBusErr(err)
+		},
+		"SetPosition": func(trackID dbus.ObjectPath, pos int64) *dbus.Error {
+			_, err := p.Call(playerInterface+".SetPosition", trackID, pos)
+			return toDBusErr(err)
+		},
+		"OpenUri": func(uri string) *dbus.Error {
+			_, err := p.Call(playerInterface+".OpenUri", uri)
+			return toDBusErr(err)
+		},
+	}
+	propMethods := map[string]any{
+		"Get": func(iface, name string) (dbus.Variant, *dbus.Error) {
+			v, err := p.GetProperty(iface, name)
+			return v, toDBusErr(err)
+		},
+		"GetAll": func(iface string) (map[string]dbus.Variant, *dbus.Error) {
+			p.mu.Lock()
+			defer p.mu.Unlock()
+			p.advance()
+			props, err := p.properties(iface)
+			return props, toDBusErr(err)
+		},
+		"Set": func(iface, name string, value dbus.Variant) *dbus.Error {
+			return toDBusErr(p.SetProperty(iface, name, value))
+		},
+	}
+	trackListMethods := map[string]any{
+		"GetTracksMetadata": func(ids []dbus.ObjectPath) ([]map[string]dbus.Variant, *dbus.Error) {
+			p.mu.Lock()
+			defer p.mu.Unlock()
+			return p.tracksMetadata(ids), nil
+		},
+	}
+	rootMethods := map[string]any{
+		"Raise": func() *dbus.Error { return nil },
+		"Quit":  func() *dbus.Error { return nil },
+	}
+
+	if err := c.ExportMethodTable(playerMethods, mprisPath, playerInterface); err != nil {
+		return fmt.Errorf("error exporting player interface: %v", err)
+	}
+	if err := c.ExportMethodTable(propMethods, mprisPath, propertiesInterface); err != nil {
+		return fmt.Errorf("error exporting properties interface: %v", err)
+	}
+	if err := c.ExportMethodTable(trackListMethods, mprisPath, trackListInterface); err != nil {
+		return fmt.Errorf("error exporting track list interface: %v", err)
+	}
+	if err := c.ExportMethodTable(rootMethods, mprisPath, mprisInterface); err != nil {
+		return fmt.Errorf("error exporting root interface: %v", err)
+	}
+
+	reply, err := c.RequestName(busName, dbus.NameFlagDoNotQueue)
+	if err != nil {
+		return fmt.Errorf("error requesting bus name %s: %v", busName, err)
+	}
+	if reply != dbus.RequestNameReplyPrimaryOwner {
+		return fmt.Errorf("bus name %s already taken", busName)
+	}
+
+	// emit signals when tracks end on their own
+	go func() {
+		for range time.Tick(100 * time.Millisecond) {
+			p.mu.Lock()
+			p.advance()
+			p.mu.Unlock()
+		}
+	}()
+	return nil
+}
+
+// splits a script line into words, double quotes group words together
+func splitScriptLine(line string) ([]string, error) {
+	var words []string
+	var curr strings.Builder
+	inQuote, hasWord := false, false
+	for _, r := range line {
+		switch {
+		case r == '"':
+			inQuote = !inQuote
+			hasWord = true
+		case !inQuote && (r == ' ' || r == '\t'):
+			if hasWord {
+				words = append(words, curr.String())
+				curr.Reset()
+				hasWord = false
+			}
+		default:
+			curr.WriteRune(r)
+			hasWord = true
+		}
+	}
+	if inQuote {
+		return nil, fmt.Errorf("unterminated quote")
+	}
+	if hasWord {
+		words = append(words, curr.String())
+	}
+	return words, nil
+}
+
+// runScript drives the player with commands read from r, one per line:
+//
+//	track <id> <length ms> <artist[;artist...]> <title> [album] [art url]
+//	play | pause | toggle | stop | next | prev
+//	seek <ms> | seek +<ms> | seek -<ms>
+//	loop None|Track|Playlist
+//	shuffle on|off
+//	volume <0.0-1.0>
+//	sleep <ms>
+//	quit
+//
+// Empty lines and lines starting with '#' are ignored. Returns true on quit.
+func (p *FakePlayer) runScript(r io.Reader) (bool, error) {
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		words, err := splitScriptLine(line)
+		if err != nil {
+			return false, fmt.Errorf("line %d: %v", lineNo, err)
+		}
+		quit, err := p.runScriptCommand(words)
+		if err != nil {
+			return false, fmt.Errorf("line %d: %v", lineNo, err)
+		}
+		if quit {
+			return true, nil
+		}
+	}
+	return false, scanner.Err()
+}
+
+func (p *FakePlayer) runScriptCommand(words []string) (bool, error) {
+	needArgs := func(n int) error {
+		if len(words)-1 < n {
+			return fmt.Errorf("%s expects at least %d argument(s)", words[0], n)
+		}
+		return nil
+	}
+	var err error
+	switch words[0] {
+	case "track":
+		if err := needArgs(4); err != nil {
+			return false, err
+		}
+		length, perr := strconv.Atoi(words[2])
+		if perr != nil {
+			return false, fmt.Errorf("invalid length: %v", perr)
+		}
+		id := words[1]
+		if !strings.HasPrefix(id, "/") {
+			id = "/com/spotify/track/" + id
+		}
+		t := fakeTrack{
+			ID:     dbus.ObjectPath(id),
+			Length: int64(length) * 1000,
+			Artist: strings.Split(words[3], ";"),
+			Title:  words[4],
+		}
+		if len(words) > 5 {
+			t.Album = words[5]
+		}
+		if len(words) > 6 {
+			t.ArtUrl = words[6]
+		}
+		p.AddTrack(t)
+	case "play", "pause", "stop", "next":
+		_, err = p.Call(playerInterface + "." + strings.ToUpper(words[0][:1]) + words[0][1:])
+	case "toggle":
+		_, err = p.Call(playerInterface + ".PlayPause")
+	case "prev":
+		_, err = p.Call(playerInterface + ".Previous")
+	case "seek":
+		if err := needArgs(1); err != nil {
+			return false, err
+		}
+		ms, perr := strconv.Atoi(words[1])
+		if perr != nil {
+			return false, fmt.Errorf("invalid position: %v", perr)
+		}
+		if words[1][0] == '+' || words[1][0] == '-' {
+			_, err = p.Call(playerInterface+".Seek", int64(ms)*1000)
+			return false, err
+		}
+		p.mu.Lock()
+		var id dbus.ObjectPath
+		if t := p.track(); t != nil {
+			id = t.ID
+		}
+		p.mu.Unlock()
+		_, err = p.Call(playerInterface+".SetPosition", id, int64(ms)*1000)
+	case "loop":
+		if err := needArgs(1); err != nil {
+			return false, err
+		}
+		err = p.SetProperty(playerInterface, "LoopStatus", words[1])
+	case "shuffle":
+		if err := needArgs(1); err != nil {
+			return false, err
+		}
+		err = p.SetProperty(playerInterface, "Shuffle", words[1] == "on")
+	case "volume":
+		if err := needArgs(1); err != nil {
+			return false, err
+		}
+		volume, perr := strconv.ParseFloat(words[1], 64)
+		if perr != nil {
+			return false, fmt.Errorf("invalid volume: %v", perr)
+		}
+		err = p.SetProperty(playerInterface, "Volume", volume)
+	case "sleep":
+		if err := needArgs(1); err != nil {
+			return false, err
+		}
+		ms, perr := strconv.Atoi(words[1])
+		if perr != nil {
+			return false, fmt.Errorf("invalid duration: %v", perr)
+		}
+		time.Sleep(time.Duration(ms) * time.Millisecond)
+	case "quit":
+		return true, nil
+	default:
+		return false, fmt.Errorf("unknown command %s", words[0])
+	}
+	return false, err
+}
+
+// used when no script is given
+const fakePlayerDemoScript = `
+track 4uLU6hMCjMI75M1A2tKUQC 213000 "Rick Astley" "Never Gonna Give You Up" "Whenever You Need Somebody"
+track 7GhIk7Il098yCjg4BQjzvb 233000 "a-ha" "Take On Me" "Hunting High and Low"
+loop Playlist
+play
+`
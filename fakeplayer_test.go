@@ -0,0 +1,115 @@
+package main
+
+import (
+	"bufio"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+)
+
+// starts a bus of its own, skips the test if there is no dbus-daemon
+func privateBus(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("dbus-daemon"); err != nil {
+		t.Skip("dbus-daemon not found")
+	}
+	cmd := exec.Command("dbus-daemon", "--session", "--nofork", "--print-address=1")
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cmd.Start(); err != nil {
+		t.Skipf("error starting dbus-daemon: %v", err)
+	}
+	t.Cleanup(func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	})
+	addr, err := bufio.NewReader(stdout).ReadString('\n')
+	if err != nil {
+		t.Skipf("error reading the bus address: %v", err)
+	}
+	return strings.TrimSpace(addr)
+}
+
+// exports the fake player on a private bus and drives it with a script, the
+// way 'fake-player serve' does, then reads it back like a real player
+func TestFakePlayerServe(t *testing.T) {
+	addr := privateBus(t)
+	server, err := dbus.Connect(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+	p := NewFakePlayer()
+	if err := p.serve(server, playerBusName); err != nil {
+		t.Fatal(err)
+	}
+
+	other, err := dbus.Connect(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer other.Close()
+	if err := NewFakePlayer().serve(other, playerBusName); err == nil {
+		t.Error("a second player got the bus name")
+	}
+
+	client, err := dbus.Connect(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, player = client, &dbusPlayer{obj: client.Object(playerBusName, mprisPath)}
+	defer closeDBus()
+	if err := client.AddMatchSignal(dbus.WithMatchObjectPath(mprisPath), dbus.WithMatchInterface(propertiesInterface)); err != nil {
+		t.Fatal(err)
+	}
+	signals := make(chan *dbus.Signal, 10)
+	client.Signal(signals)
+
+	script := `# a comment
+track 1 180000 "Artist A;Artist B" "Some Song" Album
+play
+seek 60000
+`
+	if _, err := p.runScript(strings.NewReader(script)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.runScript(strings.NewReader("seek soon")); err == nil {
+		t.Error("invalid seek succeeded")
+	}
+
+	select {
+	case s := <-signals:
+		if changed, ok := s.Body[1].(map[string]dbus.Variant); !ok || len(changed) == 0 {
+			t.Errorf("PropertiesChanged without properties: %v", s.Body)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no PropertiesChanged signal received")
+	}
+
+	if id, err := getTrackID(); err != nil || id != "1" {
+		t.Errorf("track ID is %q, %v, want 1", id, err)
+	}
+	if title := getTrackDisplayTitle(); title != "Artist A, Artist B - Some Song" {
+		t.Errorf("title is %q", title)
+	}
+	if playing, err := getPlayingStatus(); err != nil || !playing {
+		t.Errorf("playing is %v, %v, want true", playing, err)
+	}
+	if pos, err := getPosition(); err != nil || pos < 60000 || pos > 65000 {
+		t.Errorf("position is %d, %v, want about 60000", pos, err)
+	}
+	if err := setPosition(10000); err != nil {
+		t.Fatal(err)
+	}
+	if pos, err := getPosition(); err != nil || pos < 10000 || pos > 15000 {
+		t.Errorf("position is %d, %v, want about 10000", pos, err)
+	}
+	if _, err := player.Call(playerInterface+".OpenUri", "spotify:track:1"); err == nil {
+		t.Error("OpenUri succeeded")
+	}
+}
@@ -1,18 +1,20 @@
 package main
 
 import (
-	"bufio"
+	"context"
+	"errors"
 	"fmt"
 	"os"
-	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
 type LyricLine struct {
 	StartTimeMs int    `json:"startTimeMs"`
 	Words       string `json:"words"`
+	Secondary   string `json:"secondary,omitempty"` // e.g. a romanization, see withSecondary
 }
 
 type LyricsData struct {
@@ -23,19 +25,16 @@ type LyricsData struct {
 	Length       int // in ms
 	IsLineSynced bool
 	IsError      bool
-	Is404        bool // no further refetching needed if 404 is received
+	Is404        bool   // no further refetching needed if 404 is received
+	Offset       int    // per-track offset in ms, added to the global one
+	Provider     string // provider the lyrics came from
+	FetchTime    int64  // unix timestamp
+	UserEdited   bool   // never overwritten by automatic fetches
 	Lyrics       []LyricLine
 }
 
-// currently not used, track ID should be enough since this program is called "spotify-"lyrics
-func (data *LyricsData) formatName() string {
-	f := func(str string) string {
-		return strings.ReplaceAll(strings.TrimSpace(str), " ", "_")
-	}
-	return fmt.Sprintf("%s - %s - %s", f(data.Artist), f(data.Title), f(data.Album))
-}
-
-func NewLyricsDataCache(content string) (*LyricsData, error) {
+// decodes a cache file of the old format, see Cache.migrate
+func decodeCache(content string) (*LyricsData, error) {
 	lines := strings.Split(strings.TrimSpace(content), "\n")
 	if len(lines) == 0 {
 		return nil, fmt.Errorf("invalid cached lyrics format: no lines found")
@@ -45,21 +44,24 @@ func NewLyricsDataCache(content string) (*LyricsData, error) {
 	is404 := lines[0] == "404"
 
 	if isError || is404 {
-		// check if cache is expired
-		fetchTime, err := strconv.Atoi(lines[1])
+		if len(lines) < 2 {
+			return nil, fmt.Errorf("invalid cached lyrics format: missing fetch time")
+		}
+		fetchTime, err := strconv.ParseInt(lines[1], 10, 64)
 		if err != nil {
 			return nil, fmt.Errorf("invalid cached lyrics format: error parsing fetch time '%s': %v", lines[1], err)
 		}
-		currTime := time.Now().Unix()
-		if (isError && currTime-int64(fetchTime) >= int64(REFETCH_INTERVAL_SEC)) ||
-			(is404 && currTime-int64(fetchTime) >= int64(REFETCH_INTERVAL_SEC_404)) {
-			return nil, fmt.Errorf("cached state expired, need to refetch")
+		data := &LyricsData{
+			IsError:   true,
+			Is404:     is404,
+			FetchTime: fetchTime,
 		}
-		// if not, avoid refetching by not returning an error
-		return &LyricsData{
-			IsError: isError,
-			Is404:   is404,
-		}, nil
+		// artist and title were added later, might be missing
+		if len(lines) >= 4 {
+			data.Artist = lines[2]
+			data.Title = lines[3]
+		}
+		return data, nil
 	}
 
 	data := &LyricsData{}
@@ -70,125 +72,221 @@ func NewLyricsDataCache(content string) (*LyricsData, error) {
 	return data, nil
 }
 
-func (data *LyricsData) createErrorCache(cacheFile string) error {
-	file, err := os.Create(cacheFile)
-	if err != nil {
-		return fmt.Errorf("error creating cache file: %v", err)
+// stores the fetched lyrics (or the error state) in the cache
+func (data *LyricsData) createCache(cache *Cache) {
+	data.FetchTime = time.Now().Unix()
+	// the offset set for the track outlives its lyrics, see LyricsBus.refetch
+	if rec, err := cache.Load(data.TrackID); err == nil && data.Offset == 0 {
+		data.Offset = rec.Offset
 	}
-	defer file.Close()
-	writer := bufio.NewWriter(file)
-	var state string
-	if data.Is404 {
-		state = "404"
-	} else {
-		state = "error"
+	if err := cache.Store(data.TrackID, NewCacheRecord(data)); err != nil {
+		log(fmt.Sprintf("Error caching lyrics for track ID %s: %v", data.TrackID, err))
+		return
 	}
-	fmt.Fprintln(writer, state)
-	fmt.Fprintln(writer, time.Now().Unix()) // Store the fetch time
-	writer.Flush()
-	data.IsError = true
-	return nil
-}
-
-func (data *LyricsData) createCache(cacheFile string) {
-	if err := data.lrcEncodeFile(cacheFile); err != nil {
-		log(fmt.Sprintf("Error creating cache file %s: %v", cacheFile, err))
+	if err := cache.AddAliases(data.TrackID, data.aliases()...); err != nil {
+		log(fmt.Sprintf("Error adding cache aliases: %v", err))
+	}
+	if data.IsError {
+		log(fmt.Sprintf("Cached error state for track ID %s", data.TrackID))
 	} else {
-		log(fmt.Sprintf("Cached %d lines of lyrics at %s", len(data.Lyrics), cacheFile))
+		log(fmt.Sprintf("Cached %d lines of lyrics for track ID %s", len(data.Lyrics), data.TrackID))
 	}
 }
 
-func NewLyricsDataCurrentTrack(cacheFile string) (*LyricsData, error) {
-	ret := &LyricsData{}
-	var err error
+// called with intermediate results, e.g. unsynced lyrics from Spotify while
+// lrclib.net is still being asked for synced ones
+type fetchProgress func(data *LyricsData)
 
-	// get track ID first
-	ret.TrackID, err = getTrackID()
-	if err != nil {
-		return nil, fmt.Errorf("error getting track ID: %v", err)
+// stores lyrics chosen or edited by the user under key and makes a running
+// listen reload them
+func (data *LyricsData) storeUserEdited(cacheDir, key string) error {
+	cache := NewCache(cacheDir)
+	if err := cache.StoreUserEdited(key, data); err != nil {
+		return fmt.Errorf("error storing lyrics: %v", err)
 	}
-	// get length. 'crucial' according to lrclib.net
-	ret.Length, err = getLength()
-	if err != nil {
-		return nil, fmt.Errorf("error getting track length: %v", err)
+	if err := cache.AddAliases(key, data.aliases()...); err != nil {
+		log(fmt.Sprintf("Error adding cache aliases: %v", err))
 	}
-	// get metadata. if any of these fails, set to empty and ignore
-	ret.Artist, err = getArtist()
-	if err != nil {
-		log(fmt.Sprintf("Error getting artist: %v", err))
-		ret.Artist = ""
+	if err := notifyListener(cacheDir); err != nil {
+		log(fmt.Sprintf("Error notifying the running listener: %v", err))
 	}
-	ret.Title, err = getTitle()
-	if err != nil {
-		log(fmt.Sprintf("Error getting title: %v", err))
-		ret.Title = ""
+	return nil
+}
+
+// fetches the lyrics of the given track and caches them under key. Nothing
+// is cached if ctx is cancelled, progress may be nil.
+func NewLyricsDataTrack(ctx context.Context, cache *Cache, key string, meta *TrackMetadata, progress fetchProgress) (*LyricsData, error) {
+	// only the ID is known, e.g. from the command line, but lrclib.net
+	// needs the metadata
+	if meta.Title == "" && isSpotifyTrackID(key) {
+		if resolved, err := getSpotifyTrack(key); err == nil {
+			meta = resolved
+		} else {
+			log(fmt.Sprintf("Error getting metadata of track %s: %v", key, err))
+		}
 	}
-	ret.Album, err = getAlbum()
-	if err != nil {
-		log(fmt.Sprintf("Error getting album: %v", err))
-		ret.Album = ""
+	ret := &LyricsData{
+		TrackID: key,
+		Artist:  meta.Artist,
+		Title:   meta.Title,
+		Album:   meta.Album,
+		Length:  meta.Length, // 'crucial' according to lrclib.net
 	}
+	var err error
 
-	// First try spotify API
-	log("Fetching lyrics from Spotify API...")
-	for i := 0; i < RETRY_TIMES; i++ {
-		err = ret.fetchLyricsSpotify()
-		if err == nil || ret.Is404 {
+	// First try spotify API, if this is a Spotify track
+	if isSpotifyTrackID(key) {
+		log("Fetching lyrics from Spotify API...")
+	}
+	var latency time.Duration // of the last attempt
+	attempts := 0
+	for i := 0; isSpotifyTrackID(key) && i < RETRY_TIMES; i++ {
+		start := time.Now()
+		err = ret.fetchLyricsSpotify(ctx)
+		latency, attempts = time.Since(start), i+1
+		if err == nil {
+			ret.postProcess()
+		}
+		if err == nil || ret.Is404 || ctx.Err() != nil {
 			break
 		}
 		log(fmt.Sprintf("Error fetching lyrics (attempt %d/%d): %v", i+1, RETRY_TIMES, err))
-		time.Sleep(time.Duration(RETRY_INTERVAL_SEC) * time.Second) // wait before retrying
+		sleepCtx(ctx, time.Duration(RETRY_INTERVAL_SEC)*time.Second) // wait before retrying
+	}
+	if attempts > 0 {
+		ret.recordFetch(ctx, cache, "spotify", err, latency, attempts)
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
 	}
 	// If not successful or not synced, try lrclib.net
 	if err != nil || !ret.IsLineSynced {
-		if !ret.IsLineSynced {
+		var unsynced *LyricsData
+		if err == nil && !ret.Is404 && len(ret.Lyrics) > 0 {
 			log("Fetched lyrics are not line-synced")
+			copied := *ret
+			unsynced = &copied
+			if progress != nil {
+				progress(&copied)
+			}
 		}
 		log("Fetching lyrics from lrclib.net...")
 		for i := 0; i < RETRY_TIMES; i++ {
-			err = ret.fetchLyricsLrclib()
-			if err == nil || ret.Is404 {
+			start := time.Now()
+			err = ret.fetchLyricsLrclib(ctx)
+			latency, attempts = time.Since(start), i+1
+			if err == nil {
+				ret.postProcess()
+			}
+			if err == nil || ret.Is404 || ctx.Err() != nil {
 				break
 			}
 			log(fmt.Sprintf("Error fetching lyrics from lrclib (attempt %d/%d): %v", i+1, RETRY_TIMES, err))
-			time.Sleep(time.Duration(RETRY_INTERVAL_SEC) * time.Second) // wait before retrying
+			sleepCtx(ctx, time.Duration(RETRY_INTERVAL_SEC)*time.Second) // wait before retrying
+		}
+		ret.recordFetch(ctx, cache, "lrclib", err, latency, attempts)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		// unsynced lyrics are better than none
+		if err != nil && unsynced != nil {
+			ret, err = unsynced, nil
 		}
 	}
 
 	if err != nil {
 		log(fmt.Sprintf("Failed to fetch lyrics after %d attempts: %v", RETRY_TIMES, err))
-		if err := ret.createErrorCache(cacheFile); err != nil {
-			log(fmt.Sprintf("Error creating error cache: %v", err))
-		}
+		ret.IsError = true
+		ret.createCache(cache)
 		return nil, err
 	}
-	appendFetchLog(ret.TrackID, "Fetched lyrics successfully")
-	ret.createCache(cacheFile)
+	ret.createCache(cache)
 	return ret, nil
 }
 
+type pendingFetch struct {
+	done chan struct{}
+	data *LyricsData
+	err  error
+}
+
+var (
+	pendingMu      sync.Mutex
+	pendingFetches = map[string]*pendingFetch{}
+)
+
+// like NewLyricsDataTrack, but concurrent fetches of the same key, e.g. by
+// the prefetcher and listen, share a single request. Only the caller that
+// started the request receives progress.
+func fetchShared(ctx context.Context, cache *Cache, key string, meta *TrackMetadata, progress fetchProgress) (*LyricsData, error) {
+	pendingMu.Lock()
+	for pendingFetches[key] != nil {
+		p := pendingFetches[key]
+		pendingMu.Unlock()
+		select {
+		case <-p.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		// the request was cancelled by whoever started it, try again
+		if !errors.Is(p.err, context.Canceled) {
+			return p.data, p.err
+		}
+		pendingMu.Lock()
+	}
+	p := &pendingFetch{done: make(chan struct{})}
+	pendingFetches[key] = p
+	pendingMu.Unlock()
+
+	p.data, p.err = NewLyricsDataTrack(ctx, cache, key, meta, progress)
+	pendingMu.Lock()
+	delete(pendingFetches, key)
+	pendingMu.Unlock()
+	close(p.done)
+	return p.data, p.err
+}
+
+// the cached lyrics of key, nil if there are none or they have expired
+func loadCachedData(cache *Cache, key string) *LyricsData {
+	rec, err := cache.Load(key)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log(fmt.Sprintf("Error reading cached lyrics: %v", err))
+			// ignore cache error, will fetch from API
+		}
+		return nil
+	}
+	log(fmt.Sprintf("Cache hit for track ID: %s", key))
+	if rec.Expired(time.Now()) {
+		log("Cached state expired, need to refetch")
+		return nil
+	}
+	return rec.LyricsData()
+}
+
 func fetchLyrics(cacheDir string) (*LyricsData, error) {
-	trackID, err := getTrackID()
+	meta, err := getTrackMetadata()
 	if err != nil {
-		return nil, fmt.Errorf("error getting track ID: %v", err)
+		return nil, fmt.Errorf("error getting track metadata: %v", err)
 	}
+	return fetchLyricsTrack(context.Background(), cacheDir, meta, nil)
+}
 
-	log(fmt.Sprintf("Fetching lyrics for track ID: %s", trackID))
+// the lyrics of the given track, from the cache if possible
+func fetchLyricsTrack(ctx context.Context, cacheDir string, meta *TrackMetadata, progress fetchProgress) (*LyricsData, error) {
+	cache := NewCache(cacheDir)
+	key, err := cache.KeyFor(meta)
+	if err != nil {
+		return nil, fmt.Errorf("error getting cache key: %v", err)
+	}
 
-	cacheFile := filepath.Join(cacheDir, trackID+".lrc")
+	log(fmt.Sprintf("Fetching lyrics for track ID: %s", key))
 
 	// Check cache first
-	if content, err := os.ReadFile(cacheFile); err == nil {
-		log(fmt.Sprintf("Cache hit for track ID: %s", trackID))
-		ret, err := NewLyricsDataCache(string(content))
-		if err != nil {
-			log(fmt.Sprintf("Error parsing cached lyrics: %v", err))
-			// ignore cache error, will fetch from API
-		} else {
-			return ret, nil
-		}
+	if data := loadCachedData(cache, key); data != nil {
+		return data, nil
 	}
 
 	// Fetch from API
-	return NewLyricsDataCurrentTrack(cacheFile)
+	return fetchShared(ctx, cache, key, meta, progress)
 }
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// masking styles of the profanity filter
+const (
+	MASK_PARTIAL = "partial" // f***
+	MASK_FULL    = "full"    // ****
+	MASK_DROP    = "drop"    // the whole line is left out
+)
+
+// filterLanguage holds the words of a language and how they are matched
+type filterLanguage struct {
+	words []string
+	// matched anywhere, for languages without spaces between words
+	substring bool
+	// endings also matched after a word, e.g. "fucking"
+	suffixes []string
+}
+
+// built-in word lists, extended by --filter-list
+var builtinProfanity = map[string]*filterLanguage{
+	"en": {
+		words: []string{"fuck", "motherfuck", "motherfucker", "shit", "bullshit", "bitch", "cunt", "dick", "cock",
+			"pussy", "ass", "asshole", "bastard", "whore", "slut", "nigga", "nigger", "fag", "faggot", "twat",
+			"wanker", "prick", "bollocks", "goddamn"},
+		suffixes: []string{"s", "es", "ed", "er", "ers", "ing", "in", "y"},
+	},
+	"de": {
+		words:    []string{"scheiße", "scheisse", "scheiß", "scheiss", "fick", "ficken", "arschloch", "hure", "hurensohn", "fotze", "wichser", "schlampe"},
+		suffixes: []string{"e", "en", "er", "es", "s", "t"},
+	},
+	"es": {
+		words:    []string{"mierda", "puta", "puto", "joder", "jodido", "coño", "cabrón", "cabron", "pendejo", "gilipollas", "chingar", "chingada"},
+		suffixes: []string{"s", "es", "a", "as", "o", "os"},
+	},
+	"fr": {
+		words:    []string{"merde", "putain", "pute", "connard", "connasse", "salope", "enculé", "encule", "niquer", "nique"},
+		suffixes: []string{"s", "e", "es"},
+	},
+	"zh": {
+		words: []string{"操你妈", "操你媽", "肏", "他妈的", "他媽的", "傻逼", "傻屄", "煞笔", "婊子", "贱人", "賤人",
+			"草泥马", "草泥馬", "王八蛋", "屌"},
+		substring: true,
+	},
+	"ja": {
+		// not くそ, which is also part of やくそく
+		words:     []string{"クソ", "くそったれ", "クソッタレ", "ちくしょう", "チクショウ", "畜生", "ばかやろう", "バカヤロウ", "馬鹿野郎"},
+		substring: true,
+	},
+	"ko": {
+		words:     []string{"씨발", "씨팔", "개새끼", "병신", "좆", "지랄", "엿먹어"},
+		substring: true,
+	},
+}
+
+// ProfanityFilter masks words of its lists in lyric lines
+type ProfanityFilter struct {
+	style string
+	rules []filterRule
+}
+
+type filterRule struct {
+	re        *regexp.Regexp
+	substring bool
+}
+
+// builds a filter of the built-in lists of the given languages (all if
+// empty) and the user lists, see readFilterList
+func NewProfanityFilter(style string, langs []string, lists []string) (*ProfanityFilter, error) {
+	if style != MASK_PARTIAL && style != MASK_FULL && style != MASK_DROP {
+		return nil, fmt.Errorf("unknown filter style %q, expected %s, %s or %s", style, MASK_PARTIAL, MASK_FULL, MASK_DROP)
+	}
+	languages := make(map[string]*filterLanguage)
+	for lang, l := range builtinProfanity {
+		if len(langs) == 0 || slices.Contains(langs, lang) {
+			languages[lang] = &filterLanguage{words: slices.Clone(l.words), substring: l.substring, suffixes: l.suffixes}
+		}
+	}
+	for _, lang := range langs {
+		if builtinProfanity[lang] == nil {
+			return nil, fmt.Errorf("no built-in word list for language %q", lang)
+		}
+	}
+	for _, path := range lists {
+		if err := readFilterList(path, languages); err != nil {
+			return nil, fmt.Errorf("error reading filter list %s: %v", path, err)
+		}
+	}
+
+	f := &ProfanityFilter{style: style}
+	for _, l := range languages {
+		if len(l.words) == 0 {
+			continue
+		}
+		pattern := "(?i)" + alternatives(l.words)
+		if len(l.suffixes) > 0 {
+			pattern += alternatives(l.suffixes) + "?"
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		f.rules = append(f.rules, filterRule{re: re, substring: l.substring})
+	}
+	return f, nil
+}
+
+// a group matching any of the given strings, longest first, as the first
+// alternative that matches wins: "fuckers" would be left at "fucker"
+// otherwise, which is not a whole word
+func alternatives(strs []string) string {
+	strs = slices.Clone(strs)
+	sort.SliceStable(strs, func(i, j int) bool { return len(strs[i]) > len(strs[j]) })
+	for i := range strs {
+		strs[i] = regexp.QuoteMeta(strs[i])
+	}
+	return "(?:" + strings.Join(strs, "|") + ")"
+}
+
+// reads a user word list: one word or phrase per line, "[lang]" starts the
+// words of a language (en by default) and lines starting with # are comments.
+// A word prefixed with ! is removed from the lists instead.
+func readFilterList(path string, languages map[string]*filterLanguage) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	lang := "en"
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			lang = strings.ToLower(strings.Trim(line, "[]"))
+		case strings.HasPrefix(line, "!"):
+			word := strings.ToLower(strings.TrimPrefix(line, "!"))
+			for _, l := range languages {
+				l.words = slices.DeleteFunc(l.words, func(w string) bool { return strings.ToLower(w) == word })
+			}
+		default:
+			l := languages[lang]
+			if l == nil {
+				// languages without a built-in list follow its rules if
+				// there is one, otherwise words are matched as a whole
+				l = &filterLanguage{}
+				if builtin := builtinProfanity[lang]; builtin != nil {
+					l.substring, l.suffixes = builtin.substring, builtin.suffixes
+				}
+				languages[lang] = l
+			}
+			l.words = append(l.words, line)
+		}
+	}
+	return scanner.Err()
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// the filtered line, dropped is set if the line contains a listed word and
+// the style is MASK_DROP
+func (f *ProfanityFilter) Filter(line string) (filtered string, dropped bool) {
+	if f == nil {
+		return line, false
+	}
+	return f.filter(line, f.style)
+}
+
+// filters a line and its secondary text, both are dropped together
+func (f *ProfanityFilter) FilterLine(words, secondary string) (string, string, bool) {
+	words, dropped := f.Filter(words)
+	if !dropped {
+		secondary, dropped = f.Filter(secondary)
+	}
+	if dropped {
+		return "", "", true
+	}
+	return words, secondary, false
+}
+
+func (f *ProfanityFilter) filter(line, style string) (string, bool) {
+	for _, rule := range f.rules {
+		var b strings.Builder
+		last := 0
+		for _, m := range rule.re.FindAllStringIndex(line, -1) {
+			if !rule.substring && !isWholeWord(line, m[0], m[1]) {
+				continue
+			}
+			if style == MASK_DROP {
+				return "", true
+			}
+			b.WriteString(line[last:m[0]])
+			b.WriteString(mask(line[m[0]:m[1]], style))
+			last = m[1]
+		}
+		b.WriteString(line[last:])
+		line = b.String()
+	}
+	return line, false
+}
+
+// like Filter, but masks the words of lines that would be dropped, for
+// titles and the like
+func (f *ProfanityFilter) Metadata(s string) string {
+	if f == nil {
+		return s
+	}
+	style := f.style
+	if style == MASK_DROP {
+		style = MASK_FULL
+	}
+	s, _ = f.filter(s, style)
+	return s
+}
+
+// whether line[start:end] is not part of a longer word
+func isWholeWord(line string, start, end int) bool {
+	before := []rune(line[:start])
+	after := []rune(line[end:])
+	return (len(before) == 0 || !isWordRune(before[len(before)-1])) &&
+		(len(after) == 0 || !isWordRune(after[0]))
+}
+
+func mask(word, style string) string {
+	runes := []rune(word)
+	for i := range runes {
+		if i > 0 || style == MASK_FULL {
+			runes[i] = '*'
+		}
+	}
+	return string(runes)
+}
+
+// a copy of data with all lines filtered, dropped lines are kept with empty
+// words to keep the timing of synced lyrics
+func (f *ProfanityFilter) Apply(data *LyricsData) *LyricsData {
+	if f == nil || data == nil {
+		return data
+	}
+	result := *data
+	result.Lyrics = slices.Clone(data.Lyrics)
+	for i := range result.Lyrics {
+		line := &result.Lyrics[i]
+		line.Words, line.Secondary, _ = f.FilterLine(line.Words, line.Secondary)
+	}
+	// metadata can't be dropped, it is masked instead
+	for _, s := range []*string{&result.Title, &result.Artist, &result.Album} {
+		*s = f.Metadata(*s)
+	}
+	return &result
+}
@@ -0,0 +1,24 @@
+package main
+
+import "testing"
+
+func TestProfanityFilterSuffixes(t *testing.T) {
+	f, err := NewProfanityFilter(MASK_PARTIAL, []string{"en", "de"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct{ line, want string }{
+		{"you fuckers", "you f******"},
+		{"fucking hell", "f****** hell"},
+		{"what the fuck", "what the f***"},
+		{"bitches", "b******"},
+		{"class assignment", "class assignment"},
+		{"du wichser", "du w******"},
+		{"verdammte Scheiße", "verdammte S******"},
+	}
+	for _, c := range cases {
+		if got, _ := f.Filter(c.line); got != c.want {
+			t.Errorf("Filter(%q) = %q, want %q", c.line, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+)
+
+func sortLyrics(lyrics []LyricLine) {
+	sort.SliceStable(lyrics, func(i, j int) bool {
+		return lyrics[i].StartTimeMs < lyrics[j].StartTimeMs
+	})
+}
+
+// index of the first line that has not started yet at the given position,
+// lyrics must be sorted by start time
+func nextLineIndex(lyrics []LyricLine, pos int, offset int) int {
+	return sort.Search(len(lyrics), func(i int) bool {
+		return lyrics[i].StartTimeMs+offset > pos
+	})
+}
+
+// index of the line being displayed at the given position, -1 if none yet
+func currentLineIndex(lyrics []LyricLine, pos int, offset int) int {
+	return nextLineIndex(lyrics, pos, offset) - 1
+}
+
+// index of the next (or previous if backward) line matching re, starting
+// from the line after (before) `from` and wrapping around. -1 if none matches
+func findLine(lyrics []LyricLine, re *regexp.Regexp, from int, backward bool) int {
+	n := len(lyrics)
+	step := 1
+	if backward {
+		step = -1
+	}
+	for i := 1; i <= n; i++ {
+		idx := ((from+step*i)%n + n) % n
+		if re.MatchString(lyrics[idx].Words) {
+			return idx
+		}
+	}
+	return -1
+}
+
+// lyrics of the current track that can be used to seek by line. They are
+// only read from the cache, seeking should not wait for a fetch.
+func getSeekableLyrics(cacheDir string) (*LyricsData, error) {
+	key, err := getCurrentCacheKey(cacheDir)
+	if err != nil {
+		return nil, fmt.Errorf("error getting track ID: %v", err)
+	}
+	rec, err := NewCache(cacheDir).Load(key)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("no lyrics cached for track ID %s, fetch them first", key)
+	} else if err != nil {
+		return nil, fmt.Errorf("error reading cached lyrics: %v", err)
+	}
+	res := rec.LyricsData()
+	if res.IsError || len(res.Lyrics) == 0 {
+		return nil, fmt.Errorf("no lyrics found")
+	}
+	if !res.IsLineSynced {
+		return nil, fmt.Errorf("lyrics are not synchronized")
+	}
+	sortLyrics(res.Lyrics)
+	return res, nil
+}
+
+// seek to the beginning of the line with the given index
+func seekToLine(lyrics []LyricLine, idx int, offset int) error {
+	if idx < 0 || idx >= len(lyrics) {
+		return fmt.Errorf("line %d out of range (1-%d)", idx+1, len(lyrics))
+	}
+	return setPosition(max(lyrics[idx].StartTimeMs+offset, 0))
+}
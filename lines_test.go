@@ -0,0 +1,116 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestNextLineIndexSeek(t *testing.T) {
+	lyrics := []LyricLine{
+		{StartTimeMs: 1000, Words: "one"},
+		{StartTimeMs: 3000, Words: "two"},
+		{StartTimeMs: 3000, Words: "three"}, // same time as two
+		{StartTimeMs: 6000, Words: "four"},
+	}
+	// as in listen: the title, then the lyrics
+	lines := []string{"title", "one", "two", "three", "four"}
+	d := NewDisplay(1, 1, "", false)
+	d.SetMarker("> ")
+
+	cases := []struct {
+		name   string
+		pos    int
+		offset int
+		next   int
+		frame  []string
+	}{
+		{"start", 0, 0, 0, []string{"", "> title", "  one"}},
+		{"before first line", 999, 0, 0, []string{"", "> title", "  one"}},
+		{"first line starts", 1000, 0, 1, []string{"  title", "> one", "  two"}},
+		{"forward past equal timestamps", 5000, 0, 3, []string{"  two", "> three", "  four"}},
+		{"at equal timestamps", 3000, 0, 3, []string{"  two", "> three", "  four"}},
+		{"backward", 2999, 0, 1, []string{"  title", "> one", "  two"}},
+		{"backward to start", 10, 0, 0, []string{"", "> title", "  one"}},
+		{"last line", 6000, 0, 4, []string{"  three", "> four", ""}},
+		{"after last line", 100000, 0, 4, []string{"  three", "> four", ""}},
+		{"positive offset delays", 1200, 500, 0, []string{"", "> title", "  one"}},
+		{"positive offset at line", 1500, 500, 1, []string{"  title", "> one", "  two"}},
+		{"negative offset advances", 0, -1000, 1, []string{"  title", "> one", "  two"}},
+		{"negative offset past equal timestamps", 2000, -1000, 3, []string{"  two", "> three", "  four"}},
+		{"negative offset before zero", 0, -2000, 1, []string{"  title", "> one", "  two"}},
+		{"negative offset after last line", 5000, -1000, 4, []string{"  three", "> four", ""}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			next := nextLineIndex(lyrics, c.pos, c.offset)
+			if next != c.next {
+				t.Fatalf("nextLineIndex(%d, %d) = %d, want %d", c.pos, c.offset, next, c.next)
+			}
+			if current := currentLineIndex(lyrics, c.pos, c.offset); current != next-1 {
+				t.Errorf("currentLineIndex(%d, %d) = %d, want %d", c.pos, c.offset, current, next-1)
+			}
+			if frame := d.frame(lines, next); !slices.Equal(frame, c.frame) {
+				t.Errorf("frame = %q, want %q", frame, c.frame)
+			}
+		})
+	}
+}
+
+func TestNextLineIndexEmpty(t *testing.T) {
+	if next := nextLineIndex(nil, 5000, 0); next != 0 {
+		t.Errorf("nextLineIndex of no lyrics = %d, want 0", next)
+	}
+	d := NewDisplay(1, 1, "", false)
+	if frame := d.frame([]string{"title"}, 0); !slices.Equal(frame, []string{"", "title", ""}) {
+		t.Errorf("frame = %q", frame)
+	}
+}
+
+// seeking by line only uses the cache
+func TestGetSeekableLyricsCacheOnly(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("lyrics were fetched: %s", r.URL)
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+	oldURL := LRCLIB_API_URL
+	LRCLIB_API_URL = server.URL
+	defer func() { LRCLIB_API_URL = oldURL }()
+
+	cache := NewCache(t.TempDir())
+	cached := &LyricsData{
+		TrackID:      "cached",
+		Artist:       "Artist A",
+		Title:        "Cached Song",
+		Length:       180000,
+		IsLineSynced: true,
+		Lyrics:       []LyricLine{{StartTimeMs: 5000, Words: "two"}, {StartTimeMs: 1000, Words: "one"}},
+	}
+	if err := cache.Store(cached.TrackID, NewCacheRecord(cached)); err != nil {
+		t.Fatal(err)
+	}
+	if err := cache.AddAliases(cached.TrackID, cached.aliases()...); err != nil {
+		t.Fatal(err)
+	}
+
+	p := NewFakePlayer()
+	p.AddTrack(fakeTrack{ID: "/test/track/1", Length: 180000000, Artist: []string{"Artist A"}, Title: "Cached Song"})
+	p.AddTrack(fakeTrack{ID: "/test/track/2", Length: 200000000, Artist: []string{"Artist B"}, Title: "Other Song"})
+	player = p
+	defer closeDBus()
+
+	data, err := getSeekableLyrics(cache.dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data.Lyrics) != 2 || data.Lyrics[0].Words != "one" {
+		t.Errorf("got %+v, want the sorted cached lyrics", data.Lyrics)
+	}
+
+	p.Call(playerInterface + ".Next")
+	if _, err := getSeekableLyrics(cache.dir); err == nil {
+		t.Error("got lyrics of a track that is not cached")
+	}
+}
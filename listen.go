@@ -1,31 +1,65 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"syscall"
 	"time"
 )
 
 type LyricsService struct {
-	NumLines   int
-	OutputPath string
+	Before     int // lines before the current one
+	After      int // lines after the current one
+	Width      int
+	Wrap       int
+	Marker     string
+	OutputPath string // "" if there are only Outputs
 	Cls        bool
+	Stream     string // see Display.SetStream, "" to rewrite OutputPath
 	CacheDir   string
 	Offset     int
 	OffsetFile string
-	Ahead      int
+	Prefetch   int              // upcoming tracks to prefetch, 0 to disable
+	Secondary  string           // transformer of the secondary text below each line, see withSecondary
+	Filter     *ProfanityFilter // applied when lines are shown, nil for none
+	Outputs    []Output         // further outputs next to OutputPath
+	Notifier   *Notifier        // notifies about the lyrics of new tracks, nil to disable
+	BusName    string           // owned by listen to export the lyrics on the session bus, "" to disable
 
-	display    *Display
+	display    Displays
+	bus        *LyricsBus // nil if not exported
 	currTID    string
 	currRes    LyricsData
 	nextIdx    int
 	currOffset int
 	notFirst   bool
-	prevPos    int
-	prevOffset int
+	reload     atomic.Bool // set on SIGUSR1, e.g. after the cache entry was edited
+	currTitle  string
+	currMeta   *TrackMetadata
+	lastPos    int      // position at the previous proc, to notice a track starting over
+	currLines  []string // title followed by the lyrics
+
+	// lyrics are fetched in the background, results are applied in proc
+	results     chan fetchResult
+	cancelFetch context.CancelFunc
+	fetchGen    uint64 // incremented for every fetch, results of older ones are dropped
+	fetching    bool   // no final result for the current track yet
+	blocking    bool   // fetch in the foreground instead, used by print
+
+	prefetching atomic.Bool
+	noTrackList atomic.Bool // the player has no TrackList, don't ask again
+}
+
+type fetchResult struct {
+	gen   uint64 // of the fetch, see LyricsService.fetchGen
+	data  *LyricsData
+	err   error
+	final bool // false for intermediate results, see fetchProgress
 }
 
 func (l *LyricsService) loop(interval int) {
@@ -50,14 +84,27 @@ func (l *LyricsService) loop(interval int) {
 
 func (l *LyricsService) proc() {
 	trackID, err := getTrackID()
+	started := l.currTID != trackID // not if only reloading
+	if l.reload.Swap(false) {
+		log("Reloading current track")
+		l.currTID = ""
+	}
 	if l.currTID != trackID {
 		l.currTID = trackID
 		if err != nil {
 			l.display.SingleLine("No track found")
+			l.bus.SetTrack(nil, nil, SERVICE_NO_TRACK)
 			log(fmt.Sprintf("Error getting track ID: %v", err))
 			return
 		}
-		l.onTrackChanged()
+		l.onTrackChanged(started)
+	}
+	l.applyResults()
+
+	// also needed without synced lyrics, to notice repeats
+	currPos, posErr := getPosition()
+	if posErr == nil && !l.blocking {
+		l.checkRestart(currPos)
 	}
 
 	if l.currRes.IsError || !l.currRes.IsLineSynced {
@@ -65,93 +112,204 @@ func (l *LyricsService) proc() {
 		return
 	}
 
-	currPos, err := getPosition()
-	defer func() {
-		l.prevPos = currPos
-	}()
-	if err != nil {
+	if posErr != nil {
 		l.display.SingleLine("Error getting position")
-		log(fmt.Sprintf("Error getting position: %v", err))
+		log(fmt.Sprintf("Error getting position: %v", posErr))
 		return
 	}
 
-	changed := false
 	offset, err := l.getOffset()
 	if err != nil {
 		log(fmt.Sprintf("Error getting offset: %v", err))
 	} else {
 		l.currOffset = offset
 	}
-	defer func() {
-		l.prevOffset = offset
-	}()
+	offset = l.currOffset + l.currRes.Offset // per-track offset on top of the global one
 	log(fmt.Sprintf("Current position: %d, Offset: %d", currPos, offset))
-	if currPos < l.prevPos || offset != l.prevOffset {
-		// seek to the beginning if position moved backward or offset changed
-		// stupid but simple & effective :)
-		log("Position moved backward or offset changed, resetting display")
-		l.display.Clear()
-		l.nextIdx = 0
-		l.notFirst = false
-	}
-	// display first {ahead} lines
-	if !l.notFirst {
-		for i := 0; i < l.Ahead && i < len(l.currRes.Lyrics); i++ {
-			l.display.AddLine(l.currRes.Lyrics[i].Words)
-			changed = true
-		}
-		l.notFirst = true
-	}
-	for l.nextIdx < len(l.currRes.Lyrics) && l.currRes.Lyrics[l.nextIdx].StartTimeMs+l.currOffset <= currPos {
-		if l.nextIdx+l.Ahead < len(l.currRes.Lyrics) {
-			l.display.AddLine(l.currRes.Lyrics[l.nextIdx+l.Ahead].Words)
-		} else {
-			l.display.AddLine("")
-		}
-		l.nextIdx++
-		changed = true
-	}
 
-	if changed {
-		l.display.display()
+	// works for both directions, so seeking back or changing the offset
+	// no longer needs to replay everything from the first line
+	nextIdx := nextLineIndex(l.currRes.Lyrics, currPos, offset)
+	if nextIdx == l.nextIdx && l.notFirst {
+		l.bus.UpdateOffset(offset)
+		return
 	}
+	l.nextIdx = nextIdx
+	l.notFirst = true
+	l.display.Show(l.currLines, l.nextIdx)
+	l.bus.SetPosition(l.nextIdx, offset)
 }
 
-func (l *LyricsService) onTrackChanged() {
+// started is false if the same track is only reloaded
+func (l *LyricsService) onTrackChanged(started bool) {
 	log(fmt.Sprintf("Switching to track ID: %s", l.currTID))
 	l.display.Clear()
 	l.nextIdx = 0
 	l.notFirst = false
-	l.prevPos = 0
+	l.lastPos = 0
+	l.currRes = LyricsData{}
+	l.currMeta = nil
+
+	l.currTitle = getTrackDisplayTitle()
+	l.display.SetTitle(l.currTitle)
+	l.currLines = []string{l.currTitle}
+
+	// the previous track's fetch is of no use anymore
+	if l.cancelFetch != nil {
+		l.cancelFetch()
+		l.cancelFetch = nil
+	}
+	meta, err := getTrackMetadata()
+	if err != nil {
+		log(fmt.Sprintf("Error getting track metadata: %v", err))
+		l.fetching = false
+		l.setResult(nil)
+		return
+	}
+	l.currMeta = meta
+	if started && !l.blocking {
+		l.recordPlay(meta)
+	}
+	// the same track may come back before its fetch is done, e.g. A -> B -> A
+	l.fetchGen++
+	gen := l.fetchGen
+	if l.blocking {
+		result, err := fetchLyricsTrack(context.Background(), l.CacheDir, meta, nil)
+		result = l.withSecondary(context.Background(), result)
+		l.fetching = true
+		l.applyResult(fetchResult{gen: gen, data: result, err: err, final: true})
+		return
+	}
+	l.fetching = true
+	l.showMessage("Fetching lyrics…")
+	l.bus.SetTrack(meta, nil, SERVICE_FETCHING)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	l.cancelFetch = cancel
+	send := func(r fetchResult) {
+		// select picks at random if both are ready
+		if ctx.Err() != nil {
+			return
+		}
+		select {
+		case l.results <- r:
+		case <-ctx.Done():
+		}
+	}
+	go func() {
+		result, err := fetchLyricsTrack(ctx, l.CacheDir, meta, func(data *LyricsData) {
+			send(fetchResult{gen: gen, data: l.withSecondary(ctx, data)})
+		})
+		if ctx.Err() == nil {
+			send(fetchResult{gen: gen, data: l.withSecondary(ctx, result), err: err, final: true})
+		}
+	}()
+	l.prefetchUpcoming()
+}
 
-	trackInfo := getTrackDisplayTitle()
-	l.display.AddLine(trackInfo)
+// records another play when the current track starts over, e.g. on repeat
+func (l *LyricsService) checkRestart(pos int) {
+	last := l.lastPos
+	l.lastPos = pos
+	if l.currMeta != nil && last >= PLAY_MIN_MS && pos < PLAY_RESTART_MS {
+		log("Track started over")
+		l.recordPlay(l.currMeta)
+	}
+}
 
-	result, err := fetchLyrics(l.CacheDir)
-	if err != nil || result == nil {
-		l.display.AddLine("No lyrics found")
-		l.display.display()
+func (l *LyricsService) recordPlay(meta *TrackMetadata) {
+	key, err := NewCache(l.CacheDir).KeyFor(meta)
+	if err != nil {
+		key = meta.MprisID
+	}
+	recordEvent(l.CacheDir, Event{Type: EVENT_PLAY, Key: key, Artist: meta.Artist, Title: meta.Title})
+}
+
+// adds the secondary text to fetched lyrics, without it on errors
+func (l *LyricsService) withSecondary(ctx context.Context, data *LyricsData) *LyricsData {
+	if data == nil || data.IsError {
+		return data
+	}
+	result, err := withSecondary(ctx, l.Secondary, data)
+	if err != nil && ctx.Err() == nil {
+		log(err.Error())
+	}
+	return result
+}
+
+// applies the results of the background fetch that arrived so far
+func (l *LyricsService) applyResults() {
+	for {
+		select {
+		case r := <-l.results:
+			l.applyResult(r)
+		default:
+			return
+		}
+	}
+}
+
+func (l *LyricsService) applyResult(r fetchResult) {
+	if r.gen != l.fetchGen || !l.fetching {
+		return // stale
+	}
+	if r.final {
+		l.fetching = false
+		l.cancelFetch = nil
+	}
+	if r.err != nil {
+		log(fmt.Sprintf("Error fetching lyrics: %v", r.err))
+		r.data = nil
+	}
+	if !r.final {
+		log("Showing intermediate result, still fetching")
+	}
+	l.setResult(r.data)
+	if r.final {
+		l.Notifier.Track(l.currMeta, r.data, l.Filter)
+	}
+}
+
+// replaces the lyrics of the current track, result may be nil
+func (l *LyricsService) setResult(result *LyricsData) {
+	l.nextIdx = 0
+	l.notFirst = false
+	l.currLines = []string{l.currTitle}
+	if result == nil {
+		l.bus.SetTrack(l.currMeta, nil, SERVICE_404)
+		l.showMessage("No lyrics found")
 		l.currRes = LyricsData{
 			IsError: true,
 		}
 		return
 	}
 	l.currRes = *result
+	sortLyrics(l.currRes.Lyrics) // binary search in proc relies on this
+	l.bus.SetTrack(l.currMeta, &l.currRes, serviceState(&l.currRes))
+	for _, line := range l.currRes.Lyrics {
+		if line.Secondary != "" {
+			l.currLines = append(l.currLines, line.Words+"\n"+line.Secondary)
+		} else {
+			l.currLines = append(l.currLines, line.Words)
+		}
+	}
 	if result.IsError {
-		l.display.AddLine("Lyrics unavailable")
-		l.display.display()
+		l.showMessage("Lyrics unavailable")
 		log(fmt.Sprintf("Lyrics for track ID %s unavailable", l.currTID))
 	} else if !result.IsLineSynced {
-		l.display.AddLine("Lyrics unsynchronized")
-		l.display.display()
+		l.showMessage("Lyrics unsynchronized")
 		log(fmt.Sprintf("Lyrics for track ID %s unsynced", l.currTID))
 	} else if len(result.Lyrics) == 0 {
-		l.display.AddLine("No lyrics found")
-		l.display.display()
+		l.showMessage("No lyrics found")
 		log(fmt.Sprintf("No lyrics found for track ID %s", l.currTID))
 	}
 }
 
+// shows the title with a message as the current line
+func (l *LyricsService) showMessage(msg string) {
+	l.display.Message(l.currTitle, msg)
+}
+
 func (l *LyricsService) getOffset() (int, error) {
 	if l.OffsetFile == "" {
 		return l.Offset, nil
@@ -161,7 +319,7 @@ func (l *LyricsService) getOffset() (int, error) {
 		log(fmt.Sprintf("Error reading offset file: %v", err))
 		// If the file doesn't exist, create it with initial value 0
 		if os.IsNotExist(err) {
-			if err := os.WriteFile(l.OffsetFile, []byte("0"), 0644); err != nil {
+			if err := writeFileAtomic(l.OffsetFile, []byte("0"), 0644); err != nil {
 				return 0, fmt.Errorf("error creating offset file: %v", err)
 			}
 			log(fmt.Sprintf("Offset file created at %s with initial value 0", l.OffsetFile))
@@ -175,6 +333,35 @@ func (l *LyricsService) getOffset() (int, error) {
 	return offset, nil
 }
 
+func (s *LyricsService) initDisplay() error {
+	s.results = make(chan fetchResult, 1)
+	outputs := s.Outputs
+	if s.OutputPath != "" {
+		outputs = append([]Output{{
+			Name:   "main",
+			Path:   s.OutputPath,
+			Before: s.Before,
+			After:  s.After,
+			Width:  s.Width,
+			Wrap:   s.Wrap,
+			Marker: s.Marker,
+			Cls:    s.Cls,
+			Stream: s.Stream,
+		}}, outputs...)
+	}
+	s.display = nil
+	for _, o := range outputs {
+		d, err := o.newDisplay()
+		if err != nil {
+			return err
+		}
+		d.SetSecondary(s.Secondary != "")
+		d.SetFilter(s.Filter)
+		s.display = append(s.display, d)
+	}
+	return nil
+}
+
 func (s *LyricsService) listen(lockFile string, interval int) {
 	if interval < MIN_LISTEN_INTERVAL_MS {
 		log(fmt.Sprintf("Minimum listen interval is %d milliseconds, using that instead", MIN_LISTEN_INTERVAL_MS))
@@ -191,12 +378,38 @@ func (s *LyricsService) listen(lockFile string, interval int) {
 		os.Remove(lockFile)
 	}()
 
-	s.display = NewDisplay(s.NumLines, s.OutputPath, s.Cls)
+	reloadSig := make(chan os.Signal, 1)
+	signal.Notify(reloadSig, syscall.SIGUSR1)
+	go func() {
+		for range reloadSig {
+			s.reload.Store(true)
+		}
+	}()
+
+	if err := s.initDisplay(); err != nil {
+		log(err.Error())
+		os.Exit(1)
+	}
+	if s.BusName != "" {
+		if err := initDBus(); err != nil {
+			log(err.Error())
+			os.Exit(1)
+		}
+		if s.bus, err = newLyricsBus(conn, s.BusName, s); err != nil {
+			log(err.Error())
+			os.Exit(1)
+		}
+		log(fmt.Sprintf("Exporting lyrics as %s", s.BusName))
+	}
 	s.loop(interval)
 }
 
 // 'print' is simply 'listen' without loops
 func (s *LyricsService) print() {
-	s.display = NewDisplay(s.NumLines, s.OutputPath, s.Cls)
+	if err := s.initDisplay(); err != nil {
+		log(err.Error())
+		os.Exit(1)
+	}
+	s.blocking = true
 	s.proc()
 }
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// drives a listener against the fake player in-process: the first track is
+// cached, the second one is fetched from a fake lrclib.net
+func TestListenFakePlayer(t *testing.T) {
+	dir := t.TempDir()
+	output := filepath.Join(dir, "lyrics.txt")
+
+	cached := &LyricsData{
+		TrackID:      "cached",
+		Artist:       "Artist A",
+		Title:        "Cached Song",
+		Length:       180000,
+		IsLineSynced: true,
+		Lyrics: []LyricLine{
+			{StartTimeMs: 1000, Words: "cached one"},
+			{StartTimeMs: 5000, Words: "cached two"},
+		},
+	}
+	cache := NewCache(filepath.Join(dir, "cache"))
+	if err := cache.Store(cached.TrackID, NewCacheRecord(cached)); err != nil {
+		t.Fatal(err)
+	}
+	if err := cache.AddAliases(cached.TrackID, cached.aliases()...); err != nil {
+		t.Fatal(err)
+	}
+
+	release := make(chan struct{})
+	requested := make(chan string, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested <- r.URL.Query().Get("track_name")
+		<-release
+		w.Write([]byte(`{"syncedLyrics":"[00:01.00]fetched one\n[00:04.00]fetched two"}`))
+	}))
+	defer server.Close()
+	var released sync.Once
+	defer released.Do(func() { close(release) }) // before Close, which waits for the handler
+	oldURL := LRCLIB_API_URL
+	LRCLIB_API_URL = server.URL
+	defer func() { LRCLIB_API_URL = oldURL }()
+
+	p := NewFakePlayer()
+	p.AddTrack(fakeTrack{ID: "/test/track/1", Length: 180000000, Artist: []string{"Artist A"}, Title: "Cached Song"})
+	p.AddTrack(fakeTrack{ID: "/test/track/2", Length: 200000000, Artist: []string{"Artist B"}, Title: "Fetched Song"})
+	player = p
+	defer closeDBus()
+
+	l := &LyricsService{OutputPath: output, CacheDir: cache.dir}
+	if err := l.initDisplay(); err != nil {
+		t.Fatal(err)
+	}
+	seek := func(ms int64) {
+		t.Helper()
+		p.mu.Lock()
+		id := p.track().ID
+		p.mu.Unlock()
+		if _, err := p.Call(playerInterface+".SetPosition", id, ms*1000); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// runs proc until the output is want
+	expect := func(want string) {
+		t.Helper()
+		var got string
+		for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+			l.proc()
+			content, _ := os.ReadFile(output)
+			if got = strings.TrimSuffix(string(content), "\n"); got == want {
+				return
+			}
+		}
+		t.Fatalf("output is %q, want %q", got, want)
+	}
+
+	seek(2000)
+	expect("cached one")
+	seek(6000)
+	expect("cached two")
+	seek(500)
+	expect("Artist A - Cached Song")
+
+	if _, err := p.Call(playerInterface + ".Next"); err != nil {
+		t.Fatal(err)
+	}
+	seek(4500)
+	// proc must not wait for the fetch
+	expect("Fetching lyrics…")
+	released.Do(func() { close(release) })
+	expect("fetched two")
+	if title := <-requested; title != "Fetched Song" {
+		t.Errorf("lrclib was asked for %q, want Fetched Song", title)
+	}
+
+	key, err := cache.KeyFor(&TrackMetadata{MprisID: "/test/track/2", Artist: "Artist B", Title: "Fetched Song", Length: 200000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec, err := cache.Load(key)
+	if err != nil {
+		t.Fatalf("fetched lyrics were not cached: %v", err)
+	}
+	if data := rec.LyricsData(); !data.IsLineSynced || len(data.Lyrics) != 2 {
+		t.Errorf("cached %+v, want 2 synced lines", data)
+	}
+
+	// back to the first track, which must not be fetched again
+	p.mu.Lock()
+	p.switchTrack(0)
+	p.mu.Unlock()
+	seek(2000)
+	expect("cached one")
+	if len(requested) != 0 {
+		t.Errorf("lrclib was asked %d more times, want once", len(requested))
+	}
+}
+
+// a result of the fetch for an earlier visit of the same track, A -> B -> A,
+// must not be applied
+func TestListenStaleResult(t *testing.T) {
+	dir := t.TempDir()
+	l := &LyricsService{OutputPath: filepath.Join(dir, "lyrics.txt"), CacheDir: dir}
+	if err := l.initDisplay(); err != nil {
+		t.Fatal(err)
+	}
+	l.currTID, l.currTitle = "/test/track/1", "Artist - Song"
+	l.fetchGen, l.fetching = 3, true
+
+	stale := &LyricsData{IsLineSynced: true, Lyrics: []LyricLine{{StartTimeMs: 0, Words: "stale"}}}
+	l.applyResult(fetchResult{gen: 1, data: stale, final: true})
+	if !l.fetching || len(l.currRes.Lyrics) != 0 {
+		t.Fatalf("stale result was applied: %+v", l.currRes)
+	}
+
+	fresh := &LyricsData{IsLineSynced: true, Lyrics: []LyricLine{{StartTimeMs: 0, Words: "fresh"}}}
+	l.applyResult(fetchResult{gen: 3, data: fresh, final: true})
+	if l.fetching || len(l.currRes.Lyrics) != 1 || l.currRes.Lyrics[0].Words != "fresh" {
+		t.Fatalf("current result was not applied: %+v", l.currRes)
+	}
+}
+
+// every start of a track is a play, reloading it is not
+func TestListenRecordsPlays(t *testing.T) {
+	dir := t.TempDir()
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+	oldURL := LRCLIB_API_URL
+	LRCLIB_API_URL = server.URL
+	defer func() { LRCLIB_API_URL = oldURL }()
+
+	p := NewFakePlayer()
+	p.AddTrack(fakeTrack{ID: "/test/track/1", Length: 180000000, Artist: []string{"Artist A"}, Title: "Song A"})
+	p.AddTrack(fakeTrack{ID: "/test/track/2", Length: 200000000, Artist: []string{"Artist B"}, Title: "Song B"})
+	player = p
+	defer closeDBus()
+
+	l := &LyricsService{OutputPath: filepath.Join(dir, "lyrics.txt"), CacheDir: dir}
+	if err := l.initDisplay(); err != nil {
+		t.Fatal(err)
+	}
+	seek := func(ms int64) {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		p.setPos(ms * 1000)
+	}
+	expectPlays := func(want ...string) {
+		t.Helper()
+		l.proc()
+		// the fetch must not outlive the test, which restores LRCLIB_API_URL
+		for deadline := time.Now().Add(5 * time.Second); l.fetching && time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+			l.applyResults()
+		}
+		events, err := readEvents(dir, time.Time{}, time.Time{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, e := range events {
+			if e.Type == EVENT_PLAY {
+				got = append(got, e.Title)
+			}
+		}
+		if !slices.Equal(got, want) {
+			t.Fatalf("plays are %q, want %q", got, want)
+		}
+	}
+
+	expectPlays("Song A")
+	seek(20000)
+	expectPlays("Song A")
+	l.reload.Store(true)
+	expectPlays("Song A")
+	seek(1000) // seeking back early on is no repeat
+	expectPlays("Song A")
+
+	// on repeat, the track starts over without a new track ID
+	seek(179000)
+	expectPlays("Song A")
+	seek(500)
+	expectPlays("Song A", "Song A")
+
+	p.Call(playerInterface + ".Next")
+	expectPlays("Song A", "Song A", "Song B")
+	p.Call(playerInterface + ".Previous")
+	expectPlays("Song A", "Song A", "Song B", "Song A")
+}
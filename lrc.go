@@ -3,15 +3,18 @@ package main
 import (
 	"fmt"
 	"math"
-	"os"
 	"regexp"
 	"strconv"
 	"strings"
 )
 
+var (
+	lrcLineRe  = regexp.MustCompile(`^\[(\d+):(\d+)\.(\d{1,3})\](.*)$`)
+	lrcIDTagRe = regexp.MustCompile(`^\[[a-z#]+:.*\]$`) // [by:...], [length:...] and the like
+)
+
 func lrcDecodeLine(line string) (LyricLine, error) {
-	re := regexp.MustCompile(`^\[(\d+):(\d+)\.(\d+)\](.*)$`)
-	matches := re.FindStringSubmatch(line)
+	matches := lrcLineRe.FindStringSubmatch(line)
 
 	if len(matches) != 5 {
 		return LyricLine{}, fmt.Errorf("invalid LRC line format: %s", line)
@@ -19,10 +22,17 @@ func lrcDecodeLine(line string) (LyricLine, error) {
 
 	minutes, _ := strconv.Atoi(matches[1])
 	seconds, _ := strconv.Atoi(matches[2])
-	centiseconds, _ := strconv.Atoi(matches[3])
+	fraction, _ := strconv.Atoi(matches[3])
 	lyrics := strings.TrimSpace(matches[4])
+	if seconds >= 60 {
+		return LyricLine{}, fmt.Errorf("invalid LRC time, seconds out of range: %s", line)
+	}
+	// [mm:ss.x], [mm:ss.xx] or [mm:ss.xxx]
+	for i := len(matches[3]); i < 3; i++ {
+		fraction *= 10
+	}
 
-	startTimeMs := int(minutes*60000 + seconds*1000 + centiseconds*10)
+	startTimeMs := int(minutes*60000 + seconds*1000 + fraction)
 
 	return LyricLine{
 		StartTimeMs: startTimeMs,
@@ -31,35 +41,65 @@ func lrcDecodeLine(line string) (LyricLine, error) {
 }
 
 func lrcEncodeLine(line LyricLine) string {
+	// round before splitting, 1995ms is [00:02.00] and not [00:01.100]
+	cs := int(math.Round(float64(line.StartTimeMs) / 10.0))
 	return fmt.Sprintf("[%02d:%02d.%02d]%s",
-		line.StartTimeMs/60000,                               // minutes
-		(line.StartTimeMs/1000)%60,                           // seconds
-		int(math.Round(float64(line.StartTimeMs%1000)/10.0)), // 1/100 seconds
+		cs/6000,     // minutes
+		(cs/100)%60, // seconds
+		cs%100,      // 1/100 seconds
 		line.Words)
 }
 
 func (data *LyricsData) lrcDecodeLines(lines []string) error {
-	for _, line := range lines {
+	for _, err := range data.lrcDecode(lines) {
+		log(err.Error())
+	}
+	return nil
+}
+
+// decodes LRC lines into data, lines that could not be decoded are skipped
+// and reported with their (1-based) line number
+func (data *LyricsData) lrcDecode(lines []string) []error {
+	var errs []error
+	for n, line := range lines {
+		line = strings.TrimRight(line, "\r")
 		if strings.HasPrefix(line, "[ti:") {
 			data.Title = strings.TrimSuffix(strings.TrimPrefix(line, "[ti:"), "]")
 		} else if strings.HasPrefix(line, "[ar:") {
 			data.Artist = strings.TrimSuffix(strings.TrimPrefix(line, "[ar:"), "]")
 		} else if strings.HasPrefix(line, "[al:") {
 			data.Album = strings.TrimSuffix(strings.TrimPrefix(line, "[al:"), "]")
+		} else if strings.HasPrefix(line, "[offset:") {
+			// LRC offsets are the other way around: positive means earlier
+			offset, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(line, "[offset:"), "]"))
+			if err != nil {
+				errs = append(errs, fmt.Errorf("line %d: error decoding offset '%s': %v", n+1, line, err))
+			} else {
+				data.Offset = -offset
+			}
+		} else if strings.HasPrefix(line, "[src:") { // legacy cache files only
+			data.Provider = strings.TrimSuffix(strings.TrimPrefix(line, "[src:"), "]")
+		} else if strings.HasPrefix(line, "[fetched:") {
+			fetchTime, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(line, "[fetched:"), "]"), 10, 64)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("line %d: error decoding fetch time '%s': %v", n+1, line, err))
+			} else {
+				data.FetchTime = fetchTime
+			}
 		} else if line == "[sync:line]" {
 			data.IsLineSynced = true
 		} else if line == "[sync:unknown]" {
 			data.IsLineSynced = false
 		} else if line != "" {
 			lyricLine, err := lrcDecodeLine(line)
-			if err != nil {
-				log(fmt.Sprintf("error decoding line '%s': %v", line, err))
-			} else {
+			if err == nil {
 				data.Lyrics = append(data.Lyrics, lyricLine)
+			} else if !lrcIDTagRe.MatchString(line) { // other tags are ignored
+				errs = append(errs, fmt.Errorf("line %d: %v", n+1, err))
 			}
 		}
 	}
-	return nil
+	return errs
 }
 
 func (data *LyricsData) lrcEncodeFile(path string) error {
@@ -76,10 +116,17 @@ func (data *LyricsData) lrcEncodeFile(path string) error {
 	} else {
 		lines = append(lines, "[sync:unknown]")
 	}
+	if data.Offset != 0 {
+		lines = append(lines, fmt.Sprintf("[offset:%+d]", -data.Offset))
+	}
 	for _, lyric := range data.Lyrics {
 		lines = append(lines, lrcEncodeLine(lyric))
+		if lyric.Secondary != "" {
+			// the usual way to add translations to LRC files
+			lines = append(lines, lrcEncodeLine(LyricLine{StartTimeMs: lyric.StartTimeMs, Words: lyric.Secondary}))
+		}
 	}
 
 	content := strings.Join(lines, "\n")
-	return os.WriteFile(path, []byte(content), 0644)
+	return writeOutputFile(path, []byte(content))
 }
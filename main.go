@@ -1,12 +1,26 @@
 package main
 
 import (
+	"cmp"
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"maps"
+	"math"
 	"os"
-	"path/filepath"
+	"os/signal"
+	"regexp"
+	"slices"
 	"strconv"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
 
+	"github.com/godbus/dbus/v5"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 var (
@@ -16,20 +30,88 @@ var (
 	argOffsetFile string
 	argInterval   int
 	argAhead      int
+	argBefore     int
+	argAfter      int
+	argWidth      int
+	argWrap       int
+	argMarker     string
 	argCls        bool
 	argPureOutput bool
+	argPlayer     string
+	argFakeName   string
+	argJSON       bool
+	argMatch      string
+	argPrev       bool
+	argOlderThan  string
+	argPruneState string
+	argMaxSize    string
+	argDryRun     bool
+	argPrefetch   int
+	argFromFile   string
+	argPlaylist   string
+	argAlbum      string
+	argAlbumName  string
+	argJobs       int
+	argOutputDir  string
+	argArtist     string
+	argTitle      string
+	argDuration   string
+	argTrack      string
+	argPick       int
+	argSecondary  string
+	argTransURL   string
+	argTransTo    string
+	argPostProc   string
+	argFilter     string
+	argFilterList []string
+	argFilterLang []string
+	argOutputs    []string
+	argStream     string
+	argNotify     []string
+	argNotifyBody []string
+	argBusName    string
+	argSince      string
+	argUntil      string
+	argTop        int
+)
+
+// exit codes of the player control commands
+const (
+	EXIT_OK          = 0
+	EXIT_ERROR       = 1 // player not reachable or method failed
+	EXIT_INVALID_ARG = 2
 )
 
 var rootCmd = &cobra.Command{
 	Use:   "spotify-lyrics",
 	Short: "A tool to fetch and display Spotify lyrics",
 	Long:  "A command-line tool to fetch and display Spotify lyrics with caching support.",
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		playerBusName = mprisPrefix + argPlayer
+		if _, err := parsePostProcess(argPostProc); err != nil {
+			log(err.Error())
+			os.Exit(EXIT_INVALID_ARG)
+		}
+		POSTPROCESS = argPostProc
+		if cmd.Flags().Lookup("secondary") != nil {
+			if err := checkSecondary(argSecondary); err != nil {
+				log(err.Error())
+				os.Exit(EXIT_INVALID_ARG)
+			}
+			TRANSLATE_URL, TRANSLATE_TARGET = argTransURL, argTransTo
+		}
+	},
 }
 
 var fetchCmd = &cobra.Command{
-	Use:   "fetch",
-	Short: "Fetch lyrics for current track",
-	Args:  cobra.MaximumNArgs(1),
+	Use:   "fetch [track]",
+	Short: "Fetch lyrics for the current or the given track",
+	Long: `Fetch lyrics for the current or the given track.
+
+The track can be given as a Spotify track ID, URI or URL, or with --title
+and optionally --artist, --album and --duration. Neither needs a running
+player.`,
+	Args: cobra.MaximumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		cacheDir, err := getCacheDir()
 		if err != nil {
@@ -37,7 +119,17 @@ var fetchCmd = &cobra.Command{
 			return
 		}
 
-		res, err := fetchLyrics(cacheDir)
+		meta, err := fetchTrackMetadata(cmd, args)
+		if err != nil {
+			log(err.Error())
+			os.Exit(EXIT_INVALID_ARG)
+		}
+		var res *LyricsData
+		if meta == nil {
+			res, err = fetchLyrics(cacheDir)
+		} else {
+			res, err = fetchLyricsTrack(context.Background(), cacheDir, meta, nil)
+		}
 		if err != nil || res == nil || res.IsError {
 			if err != nil {
 				log(err.Error())
@@ -47,20 +139,67 @@ var fetchCmd = &cobra.Command{
 			return
 		}
 
+		if res, err = withSecondary(context.Background(), argSecondary, res); err != nil {
+			log(err.Error())
+		}
+		filter, err := newFilter()
+		if err != nil {
+			log(err.Error())
+			os.Exit(EXIT_INVALID_ARG)
+		}
 		if argPureOutput {
 			for _, lyric := range res.Lyrics {
-				fmt.Println(lyric.Words)
+				words, secondary, dropped := filter.FilterLine(lyric.Words, lyric.Secondary)
+				if dropped {
+					continue
+				}
+				fmt.Println(words)
+				if secondary != "" {
+					fmt.Println(secondary)
+				}
 			}
 		} else {
-			res.lrcEncodeFile("/dev/stdout")
+			filter.Apply(res).lrcEncodeFile("/dev/stdout")
 		}
 	},
 }
 
-var listenCmd = &cobra.Command{
-	Use:   "listen",
-	Short: "Listen mode - continuously display lyrics",
-	Run: func(cmd *cobra.Command, args []string) {
+// the track given to fetch, nil for the current one
+func fetchTrackMetadata(cmd *cobra.Command, args []string) (*TrackMetadata, error) {
+	byMetadata := false
+	for _, name := range []string{"artist", "title", "album", "duration"} {
+		byMetadata = byMetadata || cmd.Flags().Changed(name)
+	}
+	switch {
+	case len(args) > 0 && byMetadata:
+		return nil, fmt.Errorf("either a track or --artist/--title/--album/--duration can be given")
+	case len(args) > 0:
+		id, err := parseSpotifyRef(args[0], "track")
+		if err != nil {
+			return nil, err
+		}
+		return &TrackMetadata{MprisID: "spotify:track:" + id}, nil
+	case byMetadata:
+		if argTitle == "" {
+			return nil, fmt.Errorf("--title is required")
+		}
+		meta := &TrackMetadata{Artist: argArtist, Title: argTitle, Album: argAlbumName}
+		if argDuration != "" {
+			length, err := parseDuration(argDuration)
+			if err != nil {
+				return nil, err
+			}
+			meta.Length = length
+		}
+		return meta, nil
+	}
+	return nil, nil
+}
+
+// builds the service from the listen/print flags
+func newLyricsService(cmd *cobra.Command) (*LyricsService, error) {
+	// --lines and --ahead are kept for compatibility, see linesToBeforeAfter
+	if cmd.Flags().Changed("lines") || cmd.Flags().Changed("ahead") {
 		if argNumLines < 1 {
 			log("Number of lines must be positive, correcting to 1")
 			argNumLines = 1
@@ -69,22 +208,102 @@ var listenCmd = &cobra.Command{
 			log("Ahead lines must be non-negative, correcting to 0")
 			argAhead = 0
 		}
-		cacheDir, err := getCacheDir()
+		if !cmd.Flags().Changed("after") {
+			_, argAfter = linesToBeforeAfter(argNumLines, argAhead)
+		}
+		if !cmd.Flags().Changed("before") {
+			argBefore = argNumLines - 1 - argAfter
+		}
+	}
+	if argBefore < 0 || argAfter < 0 {
+		log("Number of lines before and after must be non-negative, correcting to 0")
+		argBefore, argAfter = max(argBefore, 0), max(argAfter, 0)
+	}
+	cacheDir, err := getCacheDir()
+	if err != nil {
+		return nil, fmt.Errorf("error initializing cache directory: %v", err)
+	}
+	filter, err := newFilter()
+	if err != nil {
+		return nil, err
+	}
+	var notifier *Notifier
+	if len(argNotify) > 0 {
+		if notifier, err = NewNotifier(argNotify, argNotifyBody); err != nil {
+			return nil, err
+		}
+	}
+
+	// --out adds outputs that default to the display flags, the main one
+	// is only kept if -o is given as well
+	outputPath := argOutputPath
+	if len(argOutputs) > 0 && !cmd.Flags().Changed("output") {
+		outputPath = ""
+	}
+	defaults := Output{
+		Before: argBefore,
+		After:  argAfter,
+		Width:  argWidth,
+		Wrap:   argWrap,
+		Marker: argMarker,
+		Cls:    argCls,
+	}
+	if err := checkStream(argStream); err != nil {
+		return nil, err
+	}
+	names := map[string]bool{"main": outputPath != ""}
+	var outputs []Output
+	for _, spec := range argOutputs {
+		out, err := parseOutput(spec, defaults)
 		if err != nil {
-			log(fmt.Sprintf("Error initializing cache directory: %v", err))
+			return nil, err
+		}
+		if names[out.Name] {
+			return nil, fmt.Errorf("output %s given twice", out.Name)
+		}
+		names[out.Name] = true
+		outputs = append(outputs, out)
+	}
+
+	return &LyricsService{
+		Before:     argBefore,
+		After:      argAfter,
+		Width:      argWidth,
+		Wrap:       argWrap,
+		Marker:     argMarker,
+		CacheDir:   cacheDir,
+		OutputPath: outputPath,
+		Offset:     argOffset,
+		OffsetFile: argOffsetFile,
+		Cls:        argCls,
+		Stream:     argStream,
+		Prefetch:   argPrefetch,
+		Secondary:  argSecondary,
+		Filter:     filter,
+		Outputs:    outputs,
+		Notifier:   notifier,
+		BusName:    argBusName,
+	}, nil
+}
+
+// the profanity filter given by the --filter flags, nil if disabled
+func newFilter() (*ProfanityFilter, error) {
+	if argFilter == "" {
+		return nil, nil
+	}
+	return NewProfanityFilter(argFilter, argFilterLang, argFilterList)
+}
+
+var listenCmd = &cobra.Command{
+	Use:   "listen",
+	Short: "Listen mode - continuously display lyrics",
+	Run: func(cmd *cobra.Command, args []string) {
+		service, err := newLyricsService(cmd)
+		if err != nil {
+			log(err.Error())
 			return
 		}
-		lockFile := filepath.Join(cacheDir, "spotify-lyrics.lock")
-		service := &LyricsService{
-			NumLines:   argNumLines,
-			CacheDir:   cacheDir,
-			OutputPath: argOutputPath,
-			Offset:     argOffset,
-			OffsetFile: argOffsetFile,
-			Ahead:      argAhead,
-			Cls:        argCls,
-		}
-		service.listen(lockFile, argInterval)
+		service.listen(getLockFile(service.CacheDir), argInterval)
 	},
 }
 
@@ -92,28 +311,11 @@ var printCmd = &cobra.Command{
 	Use:   "print",
 	Short: "Print mode - single shot display",
 	Run: func(cmd *cobra.Command, args []string) {
-		if argNumLines < 1 {
-			log("Number of lines must be positive, correcting to 1")
-			argNumLines = 1
-		}
-		if argAhead < 0 {
-			log("Ahead lines must be non-negative, correcting to 0")
-			argAhead = 0
-		}
-		cacheDir, err := getCacheDir()
+		service, err := newLyricsService(cmd)
 		if err != nil {
-			log(fmt.Sprintf("Error initializing cache directory: %v", err))
+			log(err.Error())
 			return
 		}
-		service := &LyricsService{
-			NumLines:   argNumLines,
-			CacheDir:   cacheDir,
-			OutputPath: argOutputPath,
-			Offset:     argOffset,
-			OffsetFile: argOffsetFile,
-			Ahead:      argAhead,
-			Cls:        argCls,
-		}
 		service.print()
 	},
 }
@@ -127,18 +329,219 @@ var clearCmd = &cobra.Command{
 			log(fmt.Sprintf("Error initializing cache directory: %v", err))
 			return
 		}
+		cache := NewCache(cacheDir)
 		if len(args) > 0 {
 			trackID := args[0]
-			trackFile := filepath.Join(cacheDir, trackID+".lrc")
-			if err := os.Remove(trackFile); err != nil {
+			if err := cache.Remove(trackID); err != nil {
 				log(fmt.Sprintf("Error removing track cache file: %v", err))
 				return
 			}
 			log(fmt.Sprintf("Cache for track ID %s cleared", trackID))
-		} else if err := os.RemoveAll(cacheDir); err != nil {
+		} else if removed, err := cache.Clear(); err != nil {
 			log(fmt.Sprintf("Error clearing cache directory: %v", err))
 		} else {
-			log("Cache directory cleared")
+			log(fmt.Sprintf("Cache directory cleared (%d entries removed)", removed))
+		}
+	},
+}
+
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Inspect and maintain the lyrics cache",
+}
+
+func mustCache() *Cache {
+	cacheDir, err := getCacheDir()
+	if err != nil {
+		log(fmt.Sprintf("Error initializing cache directory: %v", err))
+		os.Exit(EXIT_ERROR)
+	}
+	return NewCache(cacheDir)
+}
+
+func mustCacheEntries(cache *Cache) []CacheEntry {
+	entries, err := cache.Entries()
+	if err != nil {
+		log(fmt.Sprintf("Error listing cache entries: %v", err))
+		os.Exit(EXIT_ERROR)
+	}
+	return entries
+}
+
+var cacheListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List cached lyrics, oldest first",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		entries := mustCacheEntries(mustCache())
+		if argJSON {
+			out, _ := json.MarshalIndent(entries, "", "  ")
+			fmt.Println(string(out))
+			return
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "TRACK\tARTIST\tTITLE\tSTATE\tPROVIDER\tFETCHED\tSIZE")
+		for _, e := range entries {
+			state := e.State
+			if e.Edited {
+				state += " (edited)"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				e.TrackID, e.Artist, e.Title, state, cmp.Or(e.Provider, "-"),
+				e.FetchTime.Format("2006-01-02 15:04"), formatSize(e.Size))
+		}
+		w.Flush()
+	},
+}
+
+var cacheStatsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show statistics of the lyrics cache",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		entries := mustCacheEntries(mustCache())
+		var total int64
+		states := map[string]int{}
+		providers := map[string]int{}
+		for _, e := range entries {
+			total += e.Size
+			states[e.State]++
+			if e.State == "synced" || e.State == "unsynced" {
+				providers[cmp.Or(e.Provider, "unknown")]++
+			}
+		}
+		fmt.Printf("Entries: %d (%s)\n", len(entries), formatSize(total))
+		for _, state := range []string{"synced", "unsynced", "404", "error"} {
+			fmt.Printf("  %-9s %d\n", state+":", states[state])
+		}
+		if len(providers) > 0 {
+			fmt.Println("Providers:")
+			for _, provider := range slices.Sorted(maps.Keys(providers)) {
+				fmt.Printf("  %-9s %d\n", provider+":", providers[provider])
+			}
+		}
+		if len(entries) > 0 {
+			fmt.Printf("Oldest: %s\n", entries[0].FetchTime.Format(time.DateTime))
+			fmt.Printf("Newest: %s\n", entries[len(entries)-1].FetchTime.Format(time.DateTime))
+		}
+	},
+}
+
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show listening and fetch statistics from the event log",
+	Long: `Show listening and fetch statistics from the event log.
+
+Reports the plays, the lyrics hit rate and average latency of each provider,
+the most played artists and tracks and the artists whose tracks most often
+have no lyrics. --since and --until take a date (2006-01-02), a date and
+time (2006-01-02 15:04) or an age like 30d.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		now := time.Now()
+		since, err := parseEventTime(argSince, now)
+		if err != nil {
+			log(err.Error())
+			os.Exit(EXIT_INVALID_ARG)
+		}
+		until, err := parseEventTime(argUntil, now)
+		if err != nil {
+			log(err.Error())
+			os.Exit(EXIT_INVALID_ARG)
+		}
+		cacheDir, err := getCacheDir()
+		if err != nil {
+			log(fmt.Sprintf("Error initializing cache directory: %v", err))
+			os.Exit(EXIT_ERROR)
+		}
+		events, err := readEvents(cacheDir, since, until)
+		if err != nil {
+			log(fmt.Sprintf("Error reading event log: %v", err))
+			os.Exit(EXIT_ERROR)
+		}
+		stats := computeStats(events, since, until, argTop)
+		if argJSON {
+			out, _ := json.MarshalIndent(stats, "", "  ")
+			fmt.Println(string(out))
+			return
+		}
+		stats.Print()
+	},
+}
+
+var cachePruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Remove cached lyrics by age, state or total size",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		var opts PruneOptions
+		var err error
+		if argOlderThan != "" {
+			if opts.OlderThan, err = parseAge(argOlderThan); err != nil {
+				log(fmt.Sprintf("Invalid --older-than: %v", err))
+				os.Exit(EXIT_INVALID_ARG)
+			}
+		}
+		if argPruneState != "" && !slices.Contains([]string{"synced", "unsynced", "404", "error"}, argPruneState) {
+			log(fmt.Sprintf("Invalid --state: %s", argPruneState))
+			os.Exit(EXIT_INVALID_ARG)
+		}
+		opts.State = argPruneState
+		if argMaxSize != "" {
+			if opts.MaxSize, err = parseSize(argMaxSize); err != nil {
+				log(fmt.Sprintf("Invalid --max-size: %v", err))
+				os.Exit(EXIT_INVALID_ARG)
+			}
+		}
+		if opts == (PruneOptions{}) {
+			log("At least one of --older-than, --state or --max-size is required")
+			os.Exit(EXIT_INVALID_ARG)
+		}
+
+		cache := mustCache()
+		pruned := selectPrune(mustCacheEntries(cache), opts, time.Now())
+		var freed int64
+		for _, e := range pruned {
+			if argDryRun {
+				fmt.Printf("Would remove %s (%s - %s, %s)\n", e.TrackID, e.Artist, e.Title, e.State)
+			} else if err := cache.Remove(e.TrackID); err != nil {
+				log(fmt.Sprintf("Error removing %s: %v", e.TrackID, err))
+				continue
+			}
+			freed += e.Size
+		}
+		log(fmt.Sprintf("Pruned %d entries (%s)", len(pruned), formatSize(freed)))
+	},
+}
+
+var cacheShowCmd = &cobra.Command{
+	Use:   "show <trackID>",
+	Short: "Show a cached entry",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		entry, rec, err := mustCache().Entry(args[0])
+		if err != nil {
+			log(fmt.Sprintf("Error reading cache entry: %v", err))
+			os.Exit(EXIT_ERROR)
+		}
+		fmt.Printf("Track:    %s\n", entry.TrackID)
+		fmt.Printf("Artist:   %s\n", entry.Artist)
+		fmt.Printf("Title:    %s\n", entry.Title)
+		fmt.Printf("Album:    %s\n", rec.Album)
+		fmt.Printf("Length:   %d ms\n", rec.Length)
+		fmt.Printf("State:    %s\n", entry.State)
+		fmt.Printf("Provider: %s\n", cmp.Or(entry.Provider, "-"))
+		fmt.Printf("Fetched:  %s\n", entry.FetchTime.Format(time.DateTime))
+		fmt.Printf("Edited:   %t\n", rec.UserEdited)
+		fmt.Printf("Hash:     %s\n", cmp.Or(rec.Hash, "-"))
+		fmt.Printf("Size:     %s\n", formatSize(entry.Size))
+		fmt.Printf("Offset:   %d\n", rec.Offset)
+		fmt.Printf("Lines:    %d\n", len(rec.Lyrics))
+		if len(rec.Lyrics) > 0 {
+			fmt.Println()
+			for _, line := range rec.Lyrics {
+				fmt.Println(lrcEncodeLine(line))
+			}
 		}
 	},
 }
@@ -222,25 +625,861 @@ var statusCmd = &cobra.Command{
 	},
 }
 
+var fakePlayerCmd = &cobra.Command{
+	Use:   "fake-player [script]",
+	Short: "Run a scriptable fake MPRIS player (script '-' reads from stdin)",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		c, err := dbus.SessionBus()
+		if err != nil {
+			log(fmt.Sprintf("Error connecting to session bus: %v", err))
+			os.Exit(1)
+		}
+		p := NewFakePlayer()
+		if err := p.serve(c, mprisPrefix+argFakeName); err != nil {
+			log(err.Error())
+			os.Exit(1)
+		}
+		log(fmt.Sprintf("Fake player running as %s", mprisPrefix+argFakeName))
+
+		var script io.Reader = strings.NewReader(fakePlayerDemoScript)
+		if len(args) > 0 && args[0] == "-" {
+			script = os.Stdin
+		} else if len(args) > 0 {
+			file, err := os.Open(args[0])
+			if err != nil {
+				log(fmt.Sprintf("Error opening script: %v", err))
+				os.Exit(1)
+			}
+			defer file.Close()
+			script = file
+		}
+		quit, err := p.runScript(script)
+		if err != nil {
+			log(fmt.Sprintf("Error running script: %v", err))
+			os.Exit(1)
+		}
+		if quit {
+			return
+		}
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+		<-sig
+	},
+}
+
+var fakeNotifierCmd = &cobra.Command{
+	Use:   "fake-notifier",
+	Short: "Run a stub notification daemon printing the notifications it gets as JSON",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		c, err := dbus.SessionBus()
+		if err != nil {
+			log(fmt.Sprintf("Error connecting to session bus: %v", err))
+			os.Exit(1)
+		}
+		if err := (&FakeNotifier{}).serve(c); err != nil {
+			log(err.Error())
+			os.Exit(1)
+		}
+		log(fmt.Sprintf("Fake notification daemon running as %s", notificationsBusName))
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+		<-sig
+	},
+}
+
+// result of a player control command, printed as JSON with --json
+type ControlResult struct {
+	Command  string   `json:"command"`
+	Success  bool     `json:"success"`
+	Error    string   `json:"error,omitempty"`
+	Status   string   `json:"status,omitempty"`
+	Position *int     `json:"position,omitempty"`
+	Volume   *float64 `json:"volume,omitempty"`
+	Shuffle  *bool    `json:"shuffle,omitempty"`
+	Loop     string   `json:"loop,omitempty"`
+}
+
+// runs a control action, reports the result and exits with the proper code.
+// the action may fill in fields of the result, `text` is printed on success
+// when not in JSON mode.
+func runControl(cmd *cobra.Command, action func(res *ControlResult) (text string, code int, err error)) {
+	res := &ControlResult{Command: cmd.Name()}
+	text, code, err := action(res)
+	res.Success = err == nil
+	if err != nil {
+		res.Error = err.Error()
+	} else if status, serr := getPlaybackStatus(); serr == nil {
+		res.Status = status
+	}
+
+	if argJSON {
+		out, _ := json.Marshal(res)
+		fmt.Println(string(out))
+	} else if err != nil {
+		log(err.Error())
+	} else if text != "" {
+		fmt.Println(text)
+	}
+	if err != nil && code == EXIT_OK {
+		code = EXIT_ERROR
+	}
+	os.Exit(code)
+}
+
+func newPlayerMethodCmd(use, method, short string) *cobra.Command {
+	return &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			runControl(cmd, func(res *ControlResult) (string, int, error) {
+				return "", EXIT_OK, callPlayer(method)
+			})
+		},
+	}
+}
+
+var (
+	playCmd     = newPlayerMethodCmd("play", "Play", "Start or resume playback")
+	pauseCmd    = newPlayerMethodCmd("pause", "Pause", "Pause playback")
+	toggleCmd   = newPlayerMethodCmd("toggle", "PlayPause", "Toggle between play and pause")
+	nextCmd     = newPlayerMethodCmd("next", "Next", "Skip to the next track")
+	previousCmd = newPlayerMethodCmd("previous", "Previous", "Skip to the previous track")
+	stopCmd     = newPlayerMethodCmd("stop", "Stop", "Stop playback")
+)
+
+// negative numbers like the -5000 of `seek -5000` would be taken for
+// shorthand flags by cobra
+var negativeNumberRe = regexp.MustCompile(`^-\.?[0-9]`)
+
+// commands taking negative numbers as arguments
+var negativeArgCmds = []*cobra.Command{seekCmd, volumeCmd, offsetSetCmd, offsetAdjustCmd}
+
+// moves the negative numbers given to one of negativeArgCmds behind a "--",
+// so that cobra parses them as arguments and still parses the flags
+func negativeNumberArgs(args []string) []string {
+	cmd, _, err := rootCmd.Find(args)
+	if err != nil || !slices.Contains(negativeArgCmds, cmd) {
+		return args
+	}
+	cmd.InheritedFlags() // merges the persistent flags into cmd.Flags()
+	var rest, numbers []string
+	for i, arg := range args {
+		if arg == "--" {
+			numbers = append(numbers, args[i+1:]...)
+			break
+		}
+		if negativeNumberRe.MatchString(arg) && (i == 0 || !flagTakesValue(cmd, args[i-1])) {
+			numbers = append(numbers, arg)
+		} else {
+			rest = append(rest, arg)
+		}
+	}
+	if len(numbers) == 0 {
+		return args
+	}
+	return append(append(rest, "--"), numbers...)
+}
+
+// whether arg is a flag of cmd that takes the next argument as its value
+func flagTakesValue(cmd *cobra.Command, arg string) bool {
+	var flag *pflag.Flag
+	if name, ok := strings.CutPrefix(arg, "--"); ok {
+		flag = cmd.Flags().Lookup(name)
+	} else if len(arg) == 2 && arg[0] == '-' {
+		flag = cmd.Flags().ShorthandLookup(arg[1:])
+	}
+	return flag != nil && flag.NoOptDefVal == ""
+}
+
+var seekCmd = &cobra.Command{
+	Use:   "seek [+/-offset]",
+	Short: "Seek forward or backward by offset (in ms)",
+	Run: func(cmd *cobra.Command, args []string) {
+		runControl(cmd, func(res *ControlResult) (string, int, error) {
+			if err := cobra.ExactArgs(1)(cmd, args); err != nil {
+				return "", EXIT_INVALID_ARG, err
+			}
+			offset, err := strconv.Atoi(args[0])
+			if err != nil {
+				return "", EXIT_INVALID_ARG, fmt.Errorf("invalid offset: %v", err)
+			}
+			if err := seek(offset); err != nil {
+				return "", EXIT_ERROR, err
+			}
+			position, err := getPosition()
+			if err != nil {
+				return "", EXIT_OK, nil
+			}
+			res.Position = &position
+			return strconv.Itoa(position), EXIT_OK, nil
+		})
+	},
+}
+
+var volumeCmd = &cobra.Command{
+	Use:   "volume [get|set <volume>|+<delta>|-<delta>]",
+	Short: "Get or change the volume (0.0 - 1.0)",
+	Run: func(cmd *cobra.Command, args []string) {
+		runControl(cmd, func(res *ControlResult) (string, int, error) {
+			if err := cobra.MaximumNArgs(2)(cmd, args); err != nil {
+				return "", EXIT_INVALID_ARG, err
+			}
+			switch {
+			case len(args) == 0 || (args[0] == "get" && len(args) == 1):
+			case args[0] == "set" && len(args) == 2:
+				volume, err := strconv.ParseFloat(args[1], 64)
+				if err != nil {
+					return "", EXIT_INVALID_ARG, fmt.Errorf("invalid volume: %v", err)
+				}
+				if err := setVolume(volume); err != nil {
+					return "", EXIT_ERROR, err
+				}
+			case len(args) == 1 && (strings.HasPrefix(args[0], "+") || strings.HasPrefix(args[0], "-")):
+				delta, err := strconv.ParseFloat(args[0], 64)
+				if err != nil {
+					return "", EXIT_INVALID_ARG, fmt.Errorf("invalid volume delta: %v", err)
+				}
+				volume, err := getVolume()
+				if err != nil {
+					return "", EXIT_ERROR, err
+				}
+				if err := setVolume(volume + delta); err != nil {
+					return "", EXIT_ERROR, err
+				}
+			default:
+				return "", EXIT_INVALID_ARG, fmt.Errorf("invalid arguments, see --help")
+			}
+			volume, err := getVolume()
+			if err != nil {
+				return "", EXIT_ERROR, err
+			}
+			res.Volume = &volume
+			return strconv.FormatFloat(volume, 'f', 2, 64), EXIT_OK, nil
+		})
+	},
+}
+
+var shuffleCmd = &cobra.Command{
+	Use:       "shuffle [on|off|toggle]",
+	Short:     "Get or change the shuffle state",
+	Args:      cobra.MaximumNArgs(1),
+	ValidArgs: []string{"on", "off", "toggle"},
+	Run: func(cmd *cobra.Command, args []string) {
+		runControl(cmd, func(res *ControlResult) (string, int, error) {
+			if len(args) > 0 && !slices.Contains(cmd.ValidArgs, args[0]) {
+				return "", EXIT_INVALID_ARG, fmt.Errorf("invalid shuffle state: %s", args[0])
+			} else if len(args) > 0 {
+				shuffle := args[0] == "on"
+				if args[0] == "toggle" {
+					curr, err := getShuffle()
+					if err != nil {
+						return "", EXIT_ERROR, err
+					}
+					shuffle = !curr
+				}
+				if err := setShuffle(shuffle); err != nil {
+					return "", EXIT_ERROR, err
+				}
+			}
+			shuffle, err := getShuffle()
+			if err != nil {
+				return "", EXIT_ERROR, err
+			}
+			res.Shuffle = &shuffle
+			if shuffle {
+				return "on", EXIT_OK, nil
+			}
+			return "off", EXIT_OK, nil
+		})
+	},
+}
+
+var loopCmd = &cobra.Command{
+	Use:       "loop [none|track|playlist]",
+	Short:     "Get or change the loop status",
+	Args:      cobra.MaximumNArgs(1),
+	ValidArgs: []string{"none", "track", "playlist"},
+	Run: func(cmd *cobra.Command, args []string) {
+		runControl(cmd, func(res *ControlResult) (string, int, error) {
+			if len(args) > 0 && !slices.Contains(cmd.ValidArgs, args[0]) {
+				return "", EXIT_INVALID_ARG, fmt.Errorf("invalid loop status: %s", args[0])
+			} else if len(args) > 0 {
+				// "none" -> "None" as in the MPRIS spec
+				if err := setLoopStatus(strings.ToUpper(args[0][:1]) + args[0][1:]); err != nil {
+					return "", EXIT_ERROR, err
+				}
+			}
+			loop, err := getLoopStatus()
+			if err != nil {
+				return "", EXIT_ERROR, err
+			}
+			res.Loop = loop
+			return strings.ToLower(loop), EXIT_OK, nil
+		})
+	},
+}
+
+// resolves the lyrics and the offset needed by seek-line and line
+func prepareLineSeek() ([]LyricLine, int, error) {
+	cacheDir, err := getCacheDir()
+	if err != nil {
+		return nil, 0, fmt.Errorf("error initializing cache directory: %v", err)
+	}
+	res, err := getSeekableLyrics(cacheDir)
+	if err != nil {
+		return nil, 0, err
+	}
+	offset, err := (&LyricsService{Offset: argOffset, OffsetFile: argOffsetFile}).getOffset()
+	if err != nil {
+		log(fmt.Sprintf("Error getting offset: %v", err))
+	}
+	return res.Lyrics, offset + res.Offset, nil
+}
+
+var seekLineCmd = &cobra.Command{
+	Use:   "seek-line [n]",
+	Short: "Seek to the n-th (1-based) lyric line, or to the next line matching --match",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if (len(args) == 0) == (argMatch == "") {
+			log("Exactly one of a line number or --match is required")
+			os.Exit(EXIT_INVALID_ARG)
+		}
+		var re *regexp.Regexp
+		idx := -1
+		if argMatch != "" {
+			var err error
+			if re, err = regexp.Compile(argMatch); err != nil {
+				log(fmt.Sprintf("Invalid pattern: %v", err))
+				os.Exit(EXIT_INVALID_ARG)
+			}
+		} else {
+			n, err := strconv.Atoi(args[0])
+			if err != nil {
+				log(fmt.Sprintf("Invalid line number: %v", err))
+				os.Exit(EXIT_INVALID_ARG)
+			}
+			idx = n - 1
+		}
+
+		lyrics, offset, err := prepareLineSeek()
+		if err != nil {
+			log(err.Error())
+			os.Exit(EXIT_ERROR)
+		}
+		if re != nil {
+			position, err := getPosition()
+			if err != nil {
+				log(fmt.Sprintf("Error getting track position: %v", err))
+				os.Exit(EXIT_ERROR)
+			}
+			idx = findLine(lyrics, re, currentLineIndex(lyrics, position, offset), argPrev)
+			if idx < 0 {
+				log(fmt.Sprintf("No line matches %q", argMatch))
+				os.Exit(EXIT_ERROR)
+			}
+		}
+		if err := seekToLine(lyrics, idx, offset); err != nil {
+			log(err.Error())
+			os.Exit(EXIT_ERROR)
+		}
+		log(fmt.Sprintf("Seeked to line %d: %s", idx+1, lyrics[idx].Words))
+	},
+}
+
+var lineCmd = &cobra.Command{
+	Use:       "line [next|prev]",
+	Short:     "Step to the next or previous lyric line",
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: []string{"next", "prev"},
+	Run: func(cmd *cobra.Command, args []string) {
+		if !slices.Contains(cmd.ValidArgs, args[0]) {
+			log(fmt.Sprintf("Invalid direction: %s", args[0]))
+			os.Exit(EXIT_INVALID_ARG)
+		}
+		lyrics, offset, err := prepareLineSeek()
+		if err != nil {
+			log(err.Error())
+			os.Exit(EXIT_ERROR)
+		}
+		position, err := getPosition()
+		if err != nil {
+			log(fmt.Sprintf("Error getting track position: %v", err))
+			os.Exit(EXIT_ERROR)
+		}
+		idx := currentLineIndex(lyrics, position, offset)
+		if args[0] == "next" {
+			idx++
+		} else {
+			idx = max(idx-1, 0)
+		}
+		if err := seekToLine(lyrics, idx, offset); err != nil {
+			log(err.Error())
+			os.Exit(EXIT_ERROR)
+		}
+		log(fmt.Sprintf("Seeked to line %d: %s", idx+1, lyrics[idx].Words))
+	},
+}
+
+var offsetCmd = &cobra.Command{
+	Use:   "offset",
+	Short: "Get or change the per-track offset (in ms) stored in the cache",
+}
+
+var offsetGetCmd = &cobra.Command{
+	Use:   "get [trackID]",
+	Short: "Get the offset of the current or given track",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cacheDir, err := getCacheDir()
+		if err != nil {
+			log(fmt.Sprintf("Error initializing cache directory: %v", err))
+			os.Exit(EXIT_ERROR)
+		}
+		var trackID string
+		if len(args) > 0 {
+			trackID = args[0]
+		} else if trackID, err = getCurrentCacheKey(cacheDir); err != nil {
+			log(fmt.Sprintf("Error getting track ID: %v", err))
+			os.Exit(EXIT_ERROR)
+		}
+		offset, err := getTrackOffset(cacheDir, trackID)
+		if err != nil {
+			log(err.Error())
+			os.Exit(EXIT_ERROR)
+		}
+		fmt.Println(offset)
+	},
+}
+
+func newOffsetSetCmd(use, short string, relative bool) *cobra.Command {
+	return &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.RangeArgs(1, 2),
+		Run: func(cmd *cobra.Command, args []string) {
+			cacheDir, err := getCacheDir()
+			if err != nil {
+				log(fmt.Sprintf("Error initializing cache directory: %v", err))
+				os.Exit(EXIT_ERROR)
+			}
+			trackID, offset, err := parseOffsetArgs(cacheDir, args)
+			if err != nil {
+				log(err.Error())
+				os.Exit(EXIT_INVALID_ARG)
+			}
+			offset, err = setTrackOffset(cacheDir, trackID, offset, relative)
+			if err != nil {
+				log(err.Error())
+				os.Exit(EXIT_ERROR)
+			}
+			fmt.Println(offset)
+		},
+	}
+}
+
+var (
+	offsetSetCmd    = newOffsetSetCmd("set [trackID] <ms>", "Set the offset of the current or given track", false)
+	offsetAdjustCmd = newOffsetSetCmd("adjust [trackID] <+/-ms>", "Adjust the offset of the current or given track", true)
+)
+
+var prefetchCmd = &cobra.Command{
+	Use:   "prefetch",
+	Short: "Fetch lyrics of upcoming tracks into the cache",
+	Long: `Fetch lyrics of upcoming tracks into the cache.
+
+The tracks are read from --from-file (Spotify track IDs, URIs or URLs, one
+per line, - for stdin), from a --playlist or --album resolved through the
+Spotify web API, or otherwise from the player's track list if it has one.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cacheDir, err := getCacheDir()
+		if err != nil {
+			log(fmt.Sprintf("Error initializing cache directory: %v", err))
+			os.Exit(EXIT_ERROR)
+		}
+
+		var tracks []*TrackMetadata
+		switch {
+		case argFromFile != "":
+			r := io.Reader(os.Stdin)
+			if argFromFile != "-" {
+				f, err := os.Open(argFromFile)
+				if err != nil {
+					log(fmt.Sprintf("Error opening track list: %v", err))
+					os.Exit(EXIT_INVALID_ARG)
+				}
+				defer f.Close()
+				r = f
+			}
+			if tracks, err = readTrackRefs(r); err != nil {
+				log(fmt.Sprintf("Error reading track list: %v", err))
+				os.Exit(EXIT_INVALID_ARG)
+			}
+		case argPlaylist != "" || argAlbum != "":
+			kind, ref, get := "playlist", argPlaylist, getSpotifyPlaylistTracks
+			if argAlbum != "" {
+				kind, ref, get = "album", argAlbum, getSpotifyAlbumTracks
+			}
+			id, err := parseSpotifyRef(ref, kind)
+			if err != nil {
+				log(err.Error())
+				os.Exit(EXIT_INVALID_ARG)
+			}
+			if tracks, err = get(id); err != nil {
+				log(fmt.Sprintf("Error getting tracks of %s %s: %v", kind, id, err))
+				os.Exit(EXIT_ERROR)
+			}
+		default:
+			if tracks, err = getUpcomingTracks(math.MaxInt); err != nil {
+				log(fmt.Sprintf("Error getting upcoming tracks: %v", err))
+				os.Exit(EXIT_ERROR)
+			}
+		}
+
+		result := prefetch(NewCache(cacheDir), tracks, argJobs)
+		fmt.Printf("%d tracks: %d fetched, %d already cached, %d failed\n",
+			len(tracks), result.Fetched, result.Cached, result.Failed)
+		if result.Failed > 0 {
+			os.Exit(EXIT_ERROR)
+		}
+	},
+}
+
+var fetchBatchCmd = &cobra.Command{
+	Use:   "fetch-batch [track...]",
+	Short: "Fetch lyrics of many tracks into the cache, e.g. for offline use",
+	Long: `Fetch lyrics of many tracks into the cache, e.g. for offline use.
+
+Tracks are given as Spotify track IDs, URIs or URLs, or as tab separated
+rows of artist, title, duration (seconds or m:ss) and optionally album.
+They are read from the arguments, from --from-file or from stdin if neither
+is given. Tracks already in the cache are not fetched again, so an
+interrupted run can simply be restarted.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cacheDir, err := getCacheDir()
+		if err != nil {
+			log(fmt.Sprintf("Error initializing cache directory: %v", err))
+			os.Exit(EXIT_ERROR)
+		}
+
+		var tracks []*TrackMetadata
+		for _, arg := range args {
+			meta, err := parseBatchLine(arg)
+			if err != nil {
+				log(err.Error())
+				os.Exit(EXIT_INVALID_ARG)
+			}
+			tracks = append(tracks, meta)
+		}
+		if argFromFile != "" || len(args) == 0 {
+			path := cmp.Or(argFromFile, "-")
+			r, err := openBatchInput(path)
+			if err != nil {
+				log(fmt.Sprintf("Error opening track list: %v", err))
+				os.Exit(EXIT_INVALID_ARG)
+			}
+			read, err := readBatchInput(r)
+			r.Close()
+			if err != nil {
+				log(fmt.Sprintf("Error reading track list: %v", err))
+				os.Exit(EXIT_INVALID_ARG)
+			}
+			tracks = append(tracks, read...)
+		}
+		if argOutputDir != "" {
+			if err := os.MkdirAll(argOutputDir, 0755); err != nil {
+				log(fmt.Sprintf("Error creating output directory: %v", err))
+				os.Exit(EXIT_ERROR)
+			}
+		}
+
+		// stop on Ctrl-C, but still print what has been done so far
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		filter, err := newFilter()
+		if err != nil {
+			log(err.Error())
+			os.Exit(EXIT_INVALID_ARG)
+		}
+		report := fetchBatch(ctx, NewCache(cacheDir), tracks, BatchOptions{
+			Jobs:      argJobs,
+			OutputDir: argOutputDir,
+			Secondary: argSecondary,
+			Filter:    filter,
+		})
+		if argJSON {
+			json.NewEncoder(os.Stdout).Encode(report)
+		} else {
+			fmt.Println(report)
+		}
+		if report.Failed > 0 || report.Skipped > 0 {
+			os.Exit(EXIT_ERROR)
+		}
+	},
+}
+
+var searchCmd = &cobra.Command{
+	Use:   "search [query]",
+	Short: "Search lyrics across providers and pin the chosen ones to a track",
+	Long: `Search lyrics across providers and pin the chosen ones to a track.
+
+The results are ranked by how well they match the current track (or the one
+given with --track) and shown as a table. Pick one with --pick N or, if
+stdin is a terminal, at the prompt. The chosen lyrics are stored as a
+user-edited cache entry, which automatic fetches never overwrite. The query
+defaults to the artist and title of the track.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cacheDir, err := getCacheDir()
+		if err != nil {
+			log(fmt.Sprintf("Error initializing cache directory: %v", err))
+			os.Exit(EXIT_ERROR)
+		}
+		key, target, err := searchTarget(NewCache(cacheDir), argTrack)
+		if err != nil {
+			// searching still works, there is just nothing to pin to
+			log(fmt.Sprintf("No track to pin lyrics to: %v", err))
+			target = nil
+		}
+		query := strings.Join(args, " ")
+		if query == "" && target != nil {
+			query = strings.TrimSpace(target.Artist + " " + target.Title)
+		}
+		if query == "" {
+			log("Nothing to search for, give a query")
+			os.Exit(EXIT_INVALID_ARG)
+		}
+
+		results := searchLyrics(context.Background(), query, target)
+		if argJSON && argPick == 0 {
+			json.NewEncoder(os.Stdout).Encode(results)
+			return
+		}
+		if len(results) == 0 {
+			log("No results")
+			os.Exit(EXIT_ERROR)
+		}
+		if !argJSON {
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "#\tPROVIDER\tARTIST\tTITLE\tALBUM\tLENGTH\tDELTA\tSYNC\tFIRST LINE")
+			for i, r := range results {
+				sync := "unsynced"
+				if r.IsLineSynced {
+					sync = "synced"
+				}
+				fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+					i+1, r.Provider, r.Artist, r.Title, cmp.Or(r.Album, "-"), formatLength(r.Length),
+					formatDelta(r, target), sync, firstLyricLine(r.LyricsData))
+			}
+			w.Flush()
+		}
+
+		pick := argPick
+		if pick == 0 && key != "" && isInteractive() {
+			fmt.Fprintf(os.Stderr, "Pin a result to %s (1-%d, empty to cancel): ", key, len(results))
+			var answer string
+			fmt.Scanln(&answer)
+			if answer == "" {
+				return
+			}
+			if pick, err = strconv.Atoi(answer); err != nil {
+				log(fmt.Sprintf("Invalid choice %s", answer))
+				os.Exit(EXIT_INVALID_ARG)
+			}
+		}
+		if pick == 0 {
+			return
+		}
+		if pick < 1 || pick > len(results) {
+			log(fmt.Sprintf("Choice %d out of range 1-%d", pick, len(results)))
+			os.Exit(EXIT_INVALID_ARG)
+		}
+		if key == "" {
+			log("No track to pin lyrics to, play one or use --track")
+			os.Exit(EXIT_ERROR)
+		}
+		if err := pinSearchResult(cacheDir, key, target, results[pick-1]); err != nil {
+			log(err.Error())
+			os.Exit(EXIT_ERROR)
+		}
+		if argJSON {
+			json.NewEncoder(os.Stdout).Encode(results[pick-1])
+		}
+		log(fmt.Sprintf("Pinned result %d to %s", pick, key))
+	},
+}
+
+var syncEditCmd = &cobra.Command{
+	Use:   "sync-edit",
+	Short: "Interactively sync the lyrics of the current track while it plays",
+	Long: `Interactively sync the lyrics of the current track while it plays.
+
+Press space whenever the selected line starts. Lines can be selected with the
+arrow keys and nudged by 100ms (left/right) or 1s (< and >), r replays the
+player from shortly before the selected line. The result is saved as a
+user-edited cache entry, which automatic fetches never overwrite.
+
+Pass the --offset or --offset-file listen uses, lines are then stamped
+without it.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cacheDir, err := getCacheDir()
+		if err != nil {
+			log(fmt.Sprintf("Error initializing cache directory: %v", err))
+			os.Exit(EXIT_ERROR)
+		}
+		offset, err := (&LyricsService{Offset: argOffset, OffsetFile: argOffsetFile}).getOffset()
+		if err != nil {
+			log(fmt.Sprintf("Error getting offset: %v", err))
+		}
+		editor, err := NewSyncEditor(cacheDir, offset)
+		if err != nil {
+			log(err.Error())
+			os.Exit(EXIT_ERROR)
+		}
+		if err := editor.run(); err != nil {
+			log(err.Error())
+			os.Exit(EXIT_ERROR)
+		}
+	},
+}
+
+var editCmd = &cobra.Command{
+	Use:   "edit [trackID]",
+	Short: "Edit the cached lyrics of the current or given track in $EDITOR",
+	Long: `Edit the cached lyrics of the current or given track in $EDITOR.
+
+The lyrics are opened as an LRC file. After the editor exits, the file is
+checked and reopened on errors. The result is saved as a user-edited cache
+entry, which automatic fetches never overwrite, and a running listen
+instance reloads it.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cacheDir, err := getCacheDir()
+		if err != nil {
+			log(fmt.Sprintf("Error initializing cache directory: %v", err))
+			os.Exit(EXIT_ERROR)
+		}
+		var trackID string
+		if len(args) > 0 {
+			// a Spotify URI or URL, or any cache key
+			if trackID, err = parseSpotifyRef(args[0], "track"); err != nil {
+				trackID = args[0]
+			}
+		} else if trackID, err = getCurrentCacheKey(cacheDir); err != nil {
+			log(fmt.Sprintf("Error getting track ID: %v", err))
+			os.Exit(EXIT_ERROR)
+		}
+		if err := editLyrics(cacheDir, trackID); err != nil {
+			log(err.Error())
+			os.Exit(EXIT_ERROR)
+		}
+	},
+}
+
 func init() {
 	// Fetch command flags
 	fetchCmd.Flags().BoolVarP(&argPureOutput, "pure", "p", false, "Output lyrics without times")
+	fetchCmd.Flags().StringVar(&argArtist, "artist", "", "Artist of the track to fetch")
+	fetchCmd.Flags().StringVar(&argTitle, "title", "", "Title of the track to fetch")
+	fetchCmd.Flags().StringVar(&argAlbumName, "album", "", "Album of the track to fetch")
+	fetchCmd.Flags().StringVar(&argDuration, "duration", "", "Duration of the track to fetch (seconds or m:ss)")
 
 	// Listen/Print command flags
-	listenCmd.Flags().IntVarP(&argNumLines, "lines", "l", 5, "Number of lines to display")
-	listenCmd.Flags().StringVarP(&argOutputPath, "output", "o", "/dev/stdout", "Output file path")
-	listenCmd.Flags().StringVarP(&argOffsetFile, "offset-file", "f", "", "File to read offset from (if not set, uses --offset)")
-	listenCmd.Flags().IntVarP(&argOffset, "offset", "O", 0, "Offset in milliseconds for lyrics timing (ignored if --offset-file is set)")
+	for _, cmd := range []*cobra.Command{listenCmd, printCmd} {
+		cmd.Flags().IntVarP(&argBefore, "before", "B", 2, "Number of lines to display before the current line")
+		cmd.Flags().IntVarP(&argAfter, "after", "A", 2, "Number of lines to display after the current line")
+		cmd.Flags().IntVarP(&argNumLines, "lines", "l", 5, "Total number of lines to display")
+		cmd.Flags().IntVarP(&argAhead, "ahead", "a", 0, "Number of lines to display ahead of current position")
+		cmd.Flags().MarkDeprecated("lines", "use --before and --after instead")
+		cmd.Flags().MarkDeprecated("ahead", "use --after instead")
+		cmd.Flags().IntVarP(&argWidth, "width", "w", 0, "Maximum width of a line in terminal columns (0 for unlimited)")
+		cmd.Flags().IntVar(&argWrap, "wrap", 1, "Number of rows a line longer than --width is wrapped into")
+		cmd.Flags().StringVarP(&argMarker, "marker", "m", "> ", "Prefix of the current line (if more than one line is displayed)")
+		cmd.Flags().StringVarP(&argOutputPath, "output", "o", "/dev/stdout", "Output file path")
+		cmd.Flags().StringArrayVar(&argOutputs, "out", nil, "Additional output NAME:PATH[:key=value,...] with its own before, after, width, wrap, marker, template, cls and stream (repeatable)")
+		cmd.Flags().StringVar(&argStream, "stream", "", "Stream newline-delimited JSON to the output instead of rewriting it: frames or events (a missing output is created as a named pipe)")
+		cmd.Flags().StringVarP(&argOffsetFile, "offset-file", "f", "", "File to read offset from (if not set, uses --offset)")
+		cmd.Flags().IntVarP(&argOffset, "offset", "O", 0, "Offset in milliseconds for lyrics timing (ignored if --offset-file is set)")
+		cmd.Flags().BoolVarP(&argCls, "cls", "c", false, "Clear the terminal before displaying lyrics")
+	}
+	// Secondary text and filter flags
+	for _, cmd := range []*cobra.Command{listenCmd, printCmd, fetchCmd, fetchBatchCmd} {
+		cmd.Flags().StringVar(&argFilter, "filter", "", "Mask profanity: partial (f***), full (****) or drop (leave out the line)")
+		cmd.Flags().StringSliceVar(&argFilterList, "filter-list", nil, "Additional word list for --filter (repeatable)")
+		cmd.Flags().StringSliceVar(&argFilterLang, "filter-lang", nil, "Languages of the built-in word lists to use (default all)")
+		cmd.Flags().StringVar(&argSecondary, "secondary", "", "Show secondary text below each line: "+strings.Join(secondaryTransformerNames(), " or "))
+		cmd.Flags().StringVar(&argTransURL, "translate-url", TRANSLATE_URL, "LibreTranslate compatible endpoint for --secondary translate")
+		cmd.Flags().StringVar(&argTransTo, "translate-to", TRANSLATE_TARGET, "Language to translate to with --secondary translate")
+	}
 	listenCmd.Flags().IntVarP(&argInterval, "interval", "i", 200, "Interval in milliseconds beteen updates")
-	listenCmd.Flags().IntVarP(&argAhead, "ahead", "a", 0, "Number of lines to display ahead of current position")
-	listenCmd.Flags().BoolVarP(&argCls, "cls", "c", false, "Clear the terminal before displaying lyrics")
+	listenCmd.Flags().IntVar(&argPrefetch, "prefetch", PREFETCH_AHEAD, "Number of upcoming tracks to prefetch if the player has a track list (0 to disable)")
+	listenCmd.Flags().StringVar(&argBusName, "dbus", "", "Export the lyrics on the session bus, under the given name if any (--dbus=NAME)")
+	listenCmd.Flags().Lookup("dbus").NoOptDefVal = lyricsServiceInterface
+	listenCmd.Flags().StringSliceVar(&argNotify, "notify", nil, "Show a desktop notification on track changes for these lyrics states: found, unsynced, 404 or all")
+	listenCmd.Flags().StringArrayVar(&argNotifyBody, "notify-body", nil, "Body of the notification of a state as STATE=TEMPLATE with {artist}, {title}, {album} and {line} (repeatable)")
+
+	// Prefetch command flags
+	prefetchCmd.Flags().StringVarP(&argFromFile, "from-file", "F", "", "File with Spotify track IDs, URIs or URLs, one per line (- for stdin)")
+	prefetchCmd.Flags().StringVar(&argPlaylist, "playlist", "", "Spotify playlist ID, URI or URL")
+	prefetchCmd.Flags().StringVar(&argAlbum, "album", "", "Spotify album ID, URI or URL")
+	prefetchCmd.Flags().IntVarP(&argJobs, "jobs", "j", PREFETCH_JOBS, "Number of concurrent fetches")
+	prefetchCmd.MarkFlagsMutuallyExclusive("from-file", "playlist", "album")
+
+	// Search command flags
+	searchCmd.Flags().StringVarP(&argTrack, "track", "t", "", "Spotify track ID, URI or URL, or cache key to search lyrics for instead of the current track")
+	searchCmd.Flags().IntVarP(&argPick, "pick", "n", 0, "Pin the n-th result without asking")
+	searchCmd.Flags().BoolVarP(&argJSON, "json", "j", false, "Print the results (or the picked one) as JSON")
+
+	// Fetch-batch command flags
+	fetchBatchCmd.Flags().StringVarP(&argFromFile, "from-file", "F", "", "File with one track per line (- for stdin)")
+	fetchBatchCmd.Flags().StringVarP(&argOutputDir, "output-dir", "d", "", "Also write the lyrics as LRC files into this directory")
+	fetchBatchCmd.Flags().IntVarP(&argJobs, "jobs", "j", PREFETCH_JOBS, "Number of concurrent fetches")
+	fetchBatchCmd.Flags().BoolVar(&argJSON, "json", false, "Print the summary as JSON")
+
+	// Line seeking command flags
+	for _, cmd := range []*cobra.Command{seekLineCmd, lineCmd} {
+		cmd.Flags().StringVarP(&argOffsetFile, "offset-file", "f", "", "File to read offset from (if not set, uses --offset)")
+		cmd.Flags().IntVarP(&argOffset, "offset", "O", 0, "Offset in milliseconds for lyrics timing (ignored if --offset-file is set)")
+		rootCmd.AddCommand(cmd)
+	}
+	seekLineCmd.Flags().StringVarP(&argMatch, "match", "m", "", "Regular expression to search for instead of a line number")
+	seekLineCmd.Flags().BoolVar(&argPrev, "prev", false, "Search for the previous instead of the next occurrence of --match")
+
+	// Cache subcommands
+	cacheListCmd.Flags().BoolVarP(&argJSON, "json", "j", false, "Print the entries as JSON")
+	cachePruneCmd.Flags().StringVar(&argOlderThan, "older-than", "", "Remove entries fetched longer ago than this (e.g. 36h, 30d)")
+	cachePruneCmd.Flags().StringVar(&argPruneState, "state", "", "Remove entries in this state (synced, unsynced, 404, error)")
+	cachePruneCmd.Flags().StringVar(&argMaxSize, "max-size", "", "Remove the oldest entries until the cache is at most this big (e.g. 10M)")
+	cachePruneCmd.Flags().BoolVarP(&argDryRun, "dry-run", "n", false, "Only print what would be removed")
+	cacheCmd.AddCommand(cacheListCmd, cacheStatsCmd, cachePruneCmd, cacheShowCmd)
+	rootCmd.AddCommand(cacheCmd)
+
+	// Stats command flags
+	statsCmd.Flags().StringVar(&argSince, "since", "", "Only count events from this date, time or age on")
+	statsCmd.Flags().StringVar(&argUntil, "until", "", "Only count events before this date, time or age")
+	statsCmd.Flags().IntVar(&argTop, "top", 10, "Number of entries of the top lists")
+	statsCmd.Flags().BoolVarP(&argJSON, "json", "j", false, "Print the statistics as JSON")
+	rootCmd.AddCommand(statsCmd)
+
+	// Offset subcommands
+	offsetCmd.AddCommand(offsetGetCmd, offsetSetCmd, offsetAdjustCmd)
+	rootCmd.AddCommand(offsetCmd)
+
+	// Fake player command flags
+	fakePlayerCmd.Flags().StringVarP(&argFakeName, "name", "n", "spotify", "Player name to own (org.mpris.MediaPlayer2.<name>)")
+
+	// Player control command flags
+	for _, cmd := range []*cobra.Command{playCmd, pauseCmd, toggleCmd, nextCmd, previousCmd, stopCmd, seekCmd, volumeCmd, shuffleCmd, loopCmd} {
+		cmd.Flags().BoolVarP(&argJSON, "json", "j", false, "Print the result as JSON")
+		rootCmd.AddCommand(cmd)
+	}
 
-	printCmd.Flags().IntVarP(&argNumLines, "lines", "l", 5, "Number of lines to display")
-	printCmd.Flags().StringVarP(&argOutputPath, "output", "o", "/dev/stdout", "Output file path")
-	printCmd.Flags().StringVarP(&argOffsetFile, "offset-file", "f", "", "File to read offset from (if not set, uses --offset)")
-	printCmd.Flags().IntVarP(&argOffset, "offset", "O", 0, "Offset in milliseconds for lyrics timing (ignored if --offset-file is set)")
-	printCmd.Flags().IntVarP(&argAhead, "ahead", "a", 0, "Number of lines to display ahead of current position")
-	printCmd.Flags().BoolVarP(&argCls, "cls", "c", false, "Clear the terminal before displaying lyrics")
+	rootCmd.PersistentFlags().StringVarP(&argPlayer, "player", "P", "spotify", "Player to talk to (org.mpris.MediaPlayer2.<player>)")
+	rootCmd.PersistentFlags().StringVar(&argPostProc, "postprocess", POSTPROCESS, "Comma separated steps applied to fetched lyrics ("+strings.Join(postProcessStepNames(), ", ")+"), or none to keep them as they are. dedupe folds different lines of the same time into the secondary text")
 
 	// Add commands to root
 	rootCmd.AddCommand(fetchCmd)
@@ -253,10 +1492,20 @@ func init() {
 	rootCmd.AddCommand(infoCmd)
 	rootCmd.AddCommand(trackIDCmd)
 	rootCmd.AddCommand(statusCmd)
+	rootCmd.AddCommand(fakePlayerCmd)
+	rootCmd.AddCommand(fakeNotifierCmd)
+	rootCmd.AddCommand(prefetchCmd)
+	rootCmd.AddCommand(fetchBatchCmd)
+	rootCmd.AddCommand(searchCmd)
+	syncEditCmd.Flags().StringVarP(&argOffsetFile, "offset-file", "f", "", "File to read the offset listen uses from (if not set, uses --offset)")
+	syncEditCmd.Flags().IntVarP(&argOffset, "offset", "O", 0, "Offset in milliseconds listen uses, subtracted from stamped lines (ignored if --offset-file is set)")
+	rootCmd.AddCommand(syncEditCmd)
+	rootCmd.AddCommand(editCmd)
 }
 
 func main() {
 	defer closeDBus()
+	rootCmd.SetArgs(negativeNumberArgs(os.Args[1:]))
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Println(err)
 		os.Exit(1)
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestNegativeNumberArgs(t *testing.T) {
+	cases := []struct {
+		args string
+		want string
+	}{
+		{"seek -5000", "seek -- -5000"},
+		{"seek 5000", "seek 5000"},
+		{"--player vlc seek -5000 --json", "--player vlc seek --json -- -5000"},
+		{"-P vlc volume -.1", "-P vlc volume -- -.1"},
+		{"seek -- -5000", "seek -- -5000"},
+		{"offset set -400", "offset set -- -400"},
+		{"offset adjust abc -400", "offset adjust abc -- -400"},
+		{"offset adjust -- -400", "offset adjust -- -400"},
+		{"listen --offset -500", "listen --offset -500"},
+		{"sync-edit -O -500", "sync-edit -O -500"},
+	}
+	for _, c := range cases {
+		if got := negativeNumberArgs(strings.Fields(c.args)); !slices.Equal(got, strings.Fields(c.want)) {
+			t.Errorf("negativeNumberArgs(%q) = %q, want %q", c.args, got, c.want)
+		}
+	}
+
+	// the rewritten arguments parse as they should
+	for _, args := range [][]string{{"-P", "vlc", "seek", "-400"}, {"offset", "adjust", "-400"}} {
+		cmd, rest, err := rootCmd.Find(negativeNumberArgs(args))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := cmd.ParseFlags(rest); err != nil {
+			t.Fatal(err)
+		}
+		if got := cmd.Flags().Args(); !slices.Equal(got, []string{"-400"}) {
+			t.Errorf("%q got arguments %q, want [-400]", args, got)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	notificationsBusName   = "org.freedesktop.Notifications"
+	notificationsPath      = "/org/freedesktop/Notifications"
+	notificationsInterface = "org.freedesktop.Notifications"
+)
+
+// lyrics states a notification can be shown for
+const (
+	NOTIFY_FOUND     = "found"    // synced lyrics
+	NOTIFY_UNSYNCED  = "unsynced" // lyrics without timing
+	NOTIFY_NOT_FOUND = "404"      // no lyrics at all
+)
+
+var notifyStates = []string{NOTIFY_FOUND, NOTIFY_UNSYNCED, NOTIFY_NOT_FOUND}
+
+// Notifier shows a desktop notification with the lyrics status when the
+// track changes. Each notification replaces the previous one.
+type Notifier struct {
+	states []string          // states to notify about
+	bodies map[string]string // body template of each state, see NOTIFY_BODIES
+	artDir string            // downloaded cover art is kept in it, see art
+
+	mu     sync.Mutex // serializes notifications, so they replace each other in order
+	lastID uint32
+}
+
+// parses the --notify states ("all" for all of them) and the STATE=TEMPLATE
+// overrides of the bodies
+func NewNotifier(states []string, bodies []string) (*Notifier, error) {
+	n := &Notifier{
+		bodies: make(map[string]string),
+		artDir: filepath.Join(os.TempDir(), fmt.Sprintf("spotify-lyrics-art-%d", os.Getuid())),
+	}
+	for state, body := range NOTIFY_BODIES {
+		n.bodies[state] = body
+	}
+	for _, state := range states {
+		switch {
+		case state == "all":
+			n.states = notifyStates
+		case slices.Contains(notifyStates, state):
+			n.states = append(n.states, state)
+		default:
+			return nil, fmt.Errorf("unknown notification state %q, expected all or some of %s", state, strings.Join(notifyStates, ", "))
+		}
+	}
+	for _, spec := range bodies {
+		state, body, ok := strings.Cut(spec, "=")
+		if !ok || !slices.Contains(notifyStates, state) {
+			return nil, fmt.Errorf("invalid notification body %q, expected STATE=TEMPLATE with STATE one of %s", spec, strings.Join(notifyStates, ", "))
+		}
+		n.bodies[state] = strings.NewReplacer(`\n`, "\n", `\t`, "\t").Replace(body)
+	}
+	return n, nil
+}
+
+// the state of fetched lyrics, data may be nil
+func notifyState(data *LyricsData) string {
+	switch {
+	case data == nil || data.IsError || len(data.Lyrics) == 0:
+		return NOTIFY_NOT_FOUND
+	case !data.IsLineSynced:
+		return NOTIFY_UNSYNCED
+	}
+	return NOTIFY_FOUND
+}
+
+// the first line with words, filtered
+func firstLine(data *LyricsData, filter *ProfanityFilter) string {
+	if data == nil || data.IsError {
+		return ""
+	}
+	for _, line := range data.Lyrics {
+		if strings.TrimSpace(line.Words) == "" {
+			continue
+		}
+		if words, _, dropped := filter.FilterLine(line.Words, line.Secondary); !dropped {
+			return words
+		}
+	}
+	return ""
+}
+
+// notifies about the lyrics of a track in the background if enabled for
+// their state
+func (n *Notifier) Track(meta *TrackMetadata, data *LyricsData, filter *ProfanityFilter) {
+	if n == nil || meta == nil {
+		return
+	}
+	state := notifyState(data)
+	if !slices.Contains(n.states, state) {
+		return
+	}
+	summary := filter.Metadata(meta.Title)
+	body := strings.NewReplacer(
+		"{artist}", filter.Metadata(meta.Artist),
+		"{title}", summary,
+		"{album}", filter.Metadata(meta.Album),
+		"{line}", firstLine(data, filter),
+	).Replace(n.bodies[state])
+	go func() {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+		icon := n.art(meta.ArtUrl)
+		if err := n.notify(summary, body, icon); err != nil {
+			log(fmt.Sprintf("Error showing notification: %v", err))
+		}
+	}()
+}
+
+// n.mu must be held
+func (n *Notifier) notify(summary, body, icon string) error {
+	if err := initDBus(); err != nil {
+		return err
+	}
+	if conn == nil {
+		return fmt.Errorf("not connected to the session bus")
+	}
+	hints := map[string]dbus.Variant{}
+	if icon != "" {
+		hints["image-path"] = dbus.MakeVariant(icon)
+	}
+	obj := conn.Object(notificationsBusName, notificationsPath)
+	call := obj.Call(notificationsInterface+".Notify", 0,
+		"spotify-lyrics", n.lastID, icon, summary, body, []string{}, hints, int32(NOTIFY_TIMEOUT_MS))
+	if call.Err != nil {
+		return call.Err
+	}
+	return call.Store(&n.lastID)
+}
+
+// a local path or file URI of the cover art at url, which is downloaded
+// first if remote. "" if there is none. Downloads are kept in the temp dir,
+// which the system cleans up, not in the cache.
+func (n *Notifier) art(url string) string {
+	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+		return url // file:// or empty
+	}
+	sum := sha1.Sum([]byte(url))
+	path := filepath.Join(n.artDir, hex.EncodeToString(sum[:]))
+	if _, err := os.Stat(path); err == nil {
+		return path
+	}
+	if err := downloadArt(url, path); err != nil {
+		log(fmt.Sprintf("Error downloading cover art: %v", err))
+		return ""
+	}
+	return path
+}
+
+func downloadArt(url, path string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), FETCH_TIMEOUT)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", USER_AGENT_HONEST)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("server returned status code: %d", resp.StatusCode)
+	}
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return writeFileAtomic(path, content, 0644)
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+)
+
+// collects the lines written by a FakeNotifier
+type notificationWriter chan fakeNotification
+
+func (w notificationWriter) Write(p []byte) (int, error) {
+	var n fakeNotification
+	if err := json.Unmarshal(p, &n); err != nil {
+		return 0, err
+	}
+	w <- n
+	return len(p), nil
+}
+
+func TestNotifierFakeNotifier(t *testing.T) {
+	addr := privateBus(t)
+	server, err := dbus.Connect(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+	received := make(notificationWriter, 10)
+	if err := (&FakeNotifier{out: received}).serve(server); err != nil {
+		t.Fatal(err)
+	}
+	client, err := dbus.Connect(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// notify uses the connection of the player
+	conn, player = client, NewFakePlayer()
+	defer closeDBus()
+
+	n, err := NewNotifier([]string{NOTIFY_FOUND, NOTIFY_NOT_FOUND}, []string{"found=♪ {line}\\n{artist}"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	next := func() fakeNotification {
+		t.Helper()
+		select {
+		case notification := <-received:
+			return notification
+		case <-time.After(5 * time.Second):
+			t.Fatal("no notification received")
+		}
+		return fakeNotification{}
+	}
+
+	synced := &LyricsData{IsLineSynced: true, Lyrics: []LyricLine{{StartTimeMs: 0, Words: ""}, {StartTimeMs: 1000, Words: "first line"}}}
+	n.Track(&TrackMetadata{Artist: "Artist A", Title: "Song A", ArtUrl: "file:///art.png"}, synced, nil)
+	first := next()
+	if first.ReplacesID != 0 || first.Summary != "Song A" || first.Body != "♪ first line\nArtist A" || first.ImagePath != "file:///art.png" {
+		t.Errorf("first notification is %+v", first)
+	}
+
+	// unsynced is not enabled
+	unsynced := &LyricsData{Lyrics: []LyricLine{{Words: "some line"}}}
+	n.Track(&TrackMetadata{Artist: "Artist B", Title: "Song B"}, unsynced, nil)
+
+	n.Track(&TrackMetadata{Artist: "Artist C", Title: "Song C"}, nil, nil)
+	second := next()
+	if second.Summary != "Song C" || second.Body != "Artist C\nNo lyrics found" {
+		t.Errorf("second notification is %+v, want the one of Song C", second)
+	}
+	if second.ReplacesID != first.ID {
+		t.Errorf("second notification replaces %d, want %d", second.ReplacesID, first.ID)
+	}
+	select {
+	case extra := <-received:
+		t.Errorf("unexpected notification %+v", extra)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"syscall"
+)
+
+func getLockFile(cacheDir string) string {
+	return filepath.Join(cacheDir, "spotify-lyrics.lock")
+}
+
+// tells a running listen instance (if any) to reload the current track
+func notifyListener(cacheDir string) error {
+	lockFile := getLockFile(cacheDir)
+	file, err := os.Open(lockFile)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("error opening lock file: %v", err)
+	}
+	defer file.Close()
+
+	// if the lock can be acquired, nobody is listening
+	if err := syscall.Flock(int(file.Fd()), syscall.LOCK_SH|syscall.LOCK_NB); err == nil {
+		syscall.Flock(int(file.Fd()), syscall.LOCK_UN)
+		return nil
+	}
+
+	var pid int
+	if _, err := fmt.Fscanf(file, "%d", &pid); err != nil {
+		return fmt.Errorf("error reading PID from lock file: %v", err)
+	}
+	if err := syscall.Kill(pid, syscall.SIGUSR1); err != nil {
+		return fmt.Errorf("error notifying listener (PID %d): %v", pid, err)
+	}
+	log(fmt.Sprintf("Notified listener (PID %d)", pid))
+	return nil
+}
+
+// reads the cached record of the given track, error states are not accepted
+func loadCachedLyrics(cache *Cache, trackID string) (*CacheRecord, error) {
+	rec, err := cache.Load(trackID)
+	if err != nil {
+		return nil, fmt.Errorf("no cached lyrics for track ID %s: %v", trackID, err)
+	}
+	if rec.State != "ok" {
+		return nil, fmt.Errorf("no lyrics available for track ID %s", trackID)
+	}
+	return rec, nil
+}
+
+func getTrackOffset(cacheDir string, trackID string) (int, error) {
+	rec, err := loadCachedLyrics(NewCache(cacheDir), trackID)
+	if err != nil {
+		return 0, err
+	}
+	return rec.Offset, nil
+}
+
+// sets (or adjusts, if relative) the offset of the given track and returns the new value
+func setTrackOffset(cacheDir string, trackID string, offset int, relative bool) (int, error) {
+	err := NewCache(cacheDir).Update(trackID, func(rec *CacheRecord) error {
+		if rec.State != "ok" {
+			return fmt.Errorf("no lyrics available for track ID %s", trackID)
+		}
+		if relative {
+			offset += rec.Offset
+		}
+		rec.Offset = offset
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error updating cache record: %v", err)
+	}
+	if err := notifyListener(cacheDir); err != nil {
+		log(err.Error())
+	}
+	return offset, nil
+}
+
+// parses "[trackID] value" where the track ID defaults to the current track
+func parseOffsetArgs(cacheDir string, args []string) (string, int, error) {
+	var trackID string
+	var err error
+	if len(args) > 1 {
+		trackID = args[0]
+	} else if trackID, err = getCurrentCacheKey(cacheDir); err != nil {
+		return "", 0, fmt.Errorf("error getting track ID: %v", err)
+	}
+	offset, err := strconv.Atoi(args[len(args)-1])
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid offset: %v", err)
+	}
+	return trackID, offset, nil
+}
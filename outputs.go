@@ -0,0 +1,167 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Output is one of the displays driven by a listen instance
+type Output struct {
+	Name     string
+	Path     string
+	Before   int
+	After    int
+	Width    int
+	Wrap     int
+	Marker   string
+	Template string // see Display.SetTemplate
+	Cls      bool
+	Stream   string // see Display.SetStream, "" to write a file
+}
+
+// translates the old --lines/--ahead pair into lines before and after the
+// current one: the old ring buffer showed `ahead` lines after the current
+// one and filled up the rest
+func linesToBeforeAfter(lines, ahead int) (before, after int) {
+	lines, ahead = max(lines, 1), max(ahead, 0)
+	after = min(ahead, lines-1)
+	return lines - 1 - after, after
+}
+
+// parses an --out spec, NAME:PATH[:OPTIONS], where OPTIONS are comma
+// separated key=value pairs overriding the defaults. A comma or backslash in
+// a value has to be escaped with a backslash.
+func parseOutput(spec string, defaults Output) (Output, error) {
+	out := defaults
+	name, rest, ok := strings.Cut(spec, ":")
+	if !ok || name == "" {
+		return out, fmt.Errorf("invalid output %q, expected NAME:PATH[:OPTIONS]", spec)
+	}
+	path, options, _ := strings.Cut(rest, ":")
+	if path == "" {
+		return out, fmt.Errorf("output %s: no path given", name)
+	}
+	out.Name, out.Path = name, path
+
+	lines, ahead := -1, -1
+	for _, option := range splitEscaped(options, ',') {
+		if option == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(option, "=")
+		if !ok {
+			return out, fmt.Errorf("output %s: invalid option %q, expected key=value", name, option)
+		}
+		var err error
+		switch key {
+		case "before":
+			out.Before, err = parseNonNegative(value)
+		case "after":
+			out.After, err = parseNonNegative(value)
+		case "lines":
+			lines, err = parseNonNegative(value)
+		case "ahead":
+			ahead, err = parseNonNegative(value)
+		case "width":
+			out.Width, err = parseNonNegative(value)
+		case "wrap":
+			out.Wrap, err = parseNonNegative(value)
+		case "marker":
+			out.Marker = value
+		case "template":
+			out.Template = value
+		case "cls":
+			out.Cls, err = strconv.ParseBool(value)
+		case "stream":
+			out.Stream, err = value, checkStream(value)
+		default:
+			return out, fmt.Errorf("output %s: unknown option %q", name, key)
+		}
+		if err != nil {
+			return out, fmt.Errorf("output %s: invalid %s: %v", name, key, err)
+		}
+	}
+	if lines >= 0 || ahead >= 0 {
+		out.Before, out.After = linesToBeforeAfter(max(lines, 1), max(ahead, 0))
+	}
+	return out, nil
+}
+
+func parseNonNegative(s string) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err == nil && n < 0 {
+		err = fmt.Errorf("must not be negative")
+	}
+	return n, err
+}
+
+// splits s at sep, except where it is escaped with a backslash. Other
+// backslashes are kept, e.g. for \n in templates.
+func splitEscaped(s string, sep rune) []string {
+	var (
+		parts []string
+		b     strings.Builder
+	)
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		switch r := runes[i]; {
+		case r == '\\' && i+1 < len(runes) && (runes[i+1] == sep || runes[i+1] == '\\'):
+			b.WriteRune(runes[i+1])
+			i++
+		case r == sep:
+			parts = append(parts, b.String())
+			b.Reset()
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return append(parts, b.String())
+}
+
+func (o *Output) newDisplay() (*Display, error) {
+	d := NewDisplay(o.Before, o.After, o.Path, o.Cls)
+	d.SetWidth(o.Width, o.Wrap)
+	d.SetMarker(o.Marker)
+	d.SetTemplate(o.Template)
+	if o.Stream != "" {
+		if err := d.SetStream(o.Stream); err != nil {
+			return nil, fmt.Errorf("output %s: %v", o.Name, err)
+		}
+	}
+	return d, nil
+}
+
+// Displays shows the same lyrics on several outputs, each with its own
+// window and format
+type Displays []*Display
+
+func (ds Displays) Show(lines []string, current int) {
+	for _, d := range ds {
+		d.Show(lines, current)
+	}
+}
+
+func (ds Displays) SingleLine(line string) {
+	for _, d := range ds {
+		d.SingleLine(line)
+	}
+}
+
+func (ds Displays) Clear() {
+	for _, d := range ds {
+		d.Clear()
+	}
+}
+
+func (ds Displays) Message(title, msg string) {
+	for _, d := range ds {
+		d.Message(title, msg)
+	}
+}
+
+func (ds Displays) SetTitle(title string) {
+	for _, d := range ds {
+		d.SetTitle(title)
+	}
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// postProcessStep cleans up lyrics as they come from a provider. Steps are
+// enabled by name with --postprocess and always run in the order below.
+type postProcessStep struct {
+	name  string
+	apply func(data *LyricsData)
+}
+
+var postProcessSteps = []postProcessStep{
+	{"normalize", func(data *LyricsData) {
+		for i := range data.Lyrics {
+			data.Lyrics[i].Words = normalizeText(data.Lyrics[i].Words)
+		}
+	}},
+	{"t2s", func(data *LyricsData) { convertLyrics(data, chineseT2S()) }},
+	{"s2t", func(data *LyricsData) { convertLyrics(data, chineseS2T()) }},
+	{"credits", removeCredits},
+	{"dedupe", dedupeTimestamps},
+}
+
+func postProcessStepNames() []string {
+	names := make([]string, len(postProcessSteps))
+	for i, step := range postProcessSteps {
+		names[i] = step.name
+	}
+	return names
+}
+
+// parses a comma separated list of steps, "" or "none" for none
+func parsePostProcess(s string) ([]string, error) {
+	if s == "" || s == "none" {
+		return nil, nil
+	}
+	steps := strings.Split(s, ",")
+	for i, step := range steps {
+		steps[i] = strings.TrimSpace(step)
+		if !slices.Contains(postProcessStepNames(), steps[i]) {
+			return nil, fmt.Errorf("unknown post-processing step %q, expected some of %s", steps[i], strings.Join(postProcessStepNames(), ", "))
+		}
+	}
+	if slices.Contains(steps, "t2s") && slices.Contains(steps, "s2t") {
+		return nil, fmt.Errorf("t2s and s2t can't be used together")
+	}
+	return steps, nil
+}
+
+// applies the steps enabled in POSTPROCESS to freshly fetched lyrics
+func (data *LyricsData) postProcess() {
+	if data == nil || data.IsError || len(data.Lyrics) == 0 {
+		return
+	}
+	enabled, err := parsePostProcess(POSTPROCESS)
+	if err != nil {
+		log(err.Error())
+		return
+	}
+	for _, step := range postProcessSteps {
+		if slices.Contains(enabled, step.name) {
+			step.apply(data)
+		}
+	}
+}
+
+// folds full-width ASCII, turns all kinds of spaces (like U+3000) into plain
+// ones, removes carriage returns and zero-width characters and collapses
+// runs of spaces
+func normalizeText(s string) string {
+	s = strings.Map(func(r rune) rune {
+		switch {
+		case r >= 0xFF01 && r <= 0xFF5E: // ！ to ～
+			return r - 0xFEE0
+		case r == '\r' || r == '\u200b' || r == '\u200c' || r == '\u200d' || r == '\ufeff': // zero-width and BOM
+			return -1
+		case unicode.IsSpace(r):
+			return ' '
+		}
+		return r
+	}, s)
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// --- Chinese script conversion ---
+
+// chineseConverter converts text by replacing the longest phrase found in
+// the table at each position
+type chineseConverter struct {
+	table     map[string]string
+	maxPhrase int // in runes
+}
+
+func newChineseConverter(table string) *chineseConverter {
+	c := &chineseConverter{table: make(map[string]string)}
+	for _, line := range strings.Split(table, "\n") {
+		from, to, ok := strings.Cut(line, "\t")
+		if !ok {
+			continue
+		}
+		c.table[from] = to
+		c.maxPhrase = max(c.maxPhrase, len([]rune(from)))
+	}
+	return c
+}
+
+func (c *chineseConverter) convert(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+	for i := 0; i < len(runes); {
+		matched := false
+		for n := min(c.maxPhrase, len(runes)-i); n > 0; n-- {
+			if to, ok := c.table[string(runes[i:i+n])]; ok {
+				b.WriteString(to)
+				i += n
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			b.WriteRune(runes[i])
+			i++
+		}
+	}
+	return b.String()
+}
+
+var (
+	chineseT2S = sync.OnceValue(func() *chineseConverter { return newChineseConverter(chineseT2STable) })
+	chineseS2T = sync.OnceValue(func() *chineseConverter { return newChineseConverter(chineseS2TTable) })
+)
+
+func convertLyrics(data *LyricsData, c *chineseConverter) {
+	for i := range data.Lyrics {
+		data.Lyrics[i].Words = c.convert(data.Lyrics[i].Words)
+		data.Lyrics[i].Secondary = c.convert(data.Lyrics[i].Secondary)
+	}
+}
+
+// --- credits ---
+
+var creditPatterns = sync.OnceValue(func() []*regexp.Regexp {
+	patterns := make([]*regexp.Regexp, 0, len(CREDIT_PATTERNS))
+	for _, p := range CREDIT_PATTERNS {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			log(fmt.Sprintf("Invalid credit pattern %q: %v", p, err))
+			continue
+		}
+		patterns = append(patterns, re)
+	}
+	return patterns
+})
+
+// removes lines like "作词 : ..." or "Composed by ..." that are not lyrics
+func removeCredits(data *LyricsData) {
+	data.Lyrics = slices.DeleteFunc(data.Lyrics, func(line LyricLine) bool {
+		words := strings.TrimSpace(line.Words)
+		for _, re := range creditPatterns() {
+			if re.MatchString(words) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// --- deduplication ---
+
+// merges lines with the same time: repeated lines are dropped, a different
+// one (usually a translation) becomes the secondary text of the first line
+func dedupeTimestamps(data *LyricsData) {
+	if !data.IsLineSynced {
+		return // all lines are at 0
+	}
+	sortLyrics(data.Lyrics)
+	merged := data.Lyrics[:0]
+	for _, line := range data.Lyrics {
+		n := len(merged)
+		if n == 0 || merged[n-1].StartTimeMs != line.StartTimeMs {
+			merged = append(merged, line)
+			continue
+		}
+		first := &merged[n-1]
+		switch {
+		case line.Words == "" || line.Words == first.Words || line.Words == first.Secondary:
+		case first.Words == "":
+			first.Words = line.Words
+		case first.Secondary == "":
+			first.Secondary = line.Words
+		default:
+			first.Secondary += " / " + line.Words
+		}
+	}
+	data.Lyrics = merged
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+)
+
+type PrefetchResult struct {
+	Cached  int // already in the cache
+	Fetched int
+	Failed  int
+}
+
+// warms the cache for the given tracks with at most `jobs` fetches running
+// at a time. Providers are rate limited on their own, see rateLimiter.
+func prefetch(cache *Cache, tracks []*TrackMetadata, jobs int) PrefetchResult {
+	type item struct {
+		key  string
+		meta *TrackMetadata
+	}
+	var items []item
+	seen := map[string]bool{}
+	for _, meta := range tracks {
+		key, err := cache.KeyFor(meta)
+		if err != nil {
+			log(fmt.Sprintf("Skipping track %s: %v", meta.MprisID, err))
+			continue
+		}
+		if !seen[key] {
+			seen[key] = true
+			items = append(items, item{key, meta})
+		}
+	}
+
+	var (
+		mu     sync.Mutex
+		result PrefetchResult
+	)
+	forEachConcurrent(context.Background(), items, jobs, func(it item) {
+		if loadCachedData(cache, it.key) != nil {
+			mu.Lock()
+			result.Cached++
+			mu.Unlock()
+			return
+		}
+		log(fmt.Sprintf("Prefetching lyrics for track ID: %s", it.key))
+		_, err := fetchShared(context.Background(), cache, it.key, it.meta, nil)
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			result.Failed++
+		} else {
+			result.Fetched++
+		}
+	})
+	return result
+}
+
+// calls fn for every item with at most `jobs` calls running at a time. No
+// new calls are started once ctx is done.
+func forEachConcurrent[T any](ctx context.Context, items []T, jobs int, fn func(T)) {
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, max(jobs, 1))
+	for _, it := range items {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			fn(it)
+		}()
+	}
+	wg.Wait()
+}
+
+// reads Spotify track IDs, URIs or URLs, one per line. Empty lines and
+// lines starting with # are ignored.
+func readTrackRefs(r io.Reader) ([]*TrackMetadata, error) {
+	var tracks []*TrackMetadata
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		id, err := parseSpotifyRef(line, "track")
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", n, err)
+		}
+		tracks = append(tracks, &TrackMetadata{MprisID: "spotify:track:" + id})
+	}
+	return tracks, scanner.Err()
+}
+
+// prefetches the upcoming tracks in the background, at most one run at a time
+func (l *LyricsService) prefetchUpcoming() {
+	if l.Prefetch <= 0 || l.noTrackList.Load() || !l.prefetching.CompareAndSwap(false, true) {
+		return
+	}
+	go func() {
+		defer l.prefetching.Store(false)
+		tracks, err := getUpcomingTracks(l.Prefetch)
+		if err != nil {
+			// most players, Spotify included, have no track list, other
+			// errors like timeouts may go away
+			log(fmt.Sprintf("Not prefetching: %v", err))
+			if isNoTrackListError(err) {
+				l.noTrackList.Store(true)
+			}
+			return
+		}
+		result := prefetch(NewCache(l.CacheDir), tracks, PREFETCH_JOBS)
+		log(fmt.Sprintf("Prefetched %d upcoming tracks: %d fetched, %d cached, %d failed",
+			len(tracks), result.Fetched, result.Cached, result.Failed))
+	}()
+}
+
+// whether err means that the player has no track list at all
+func isNoTrackListError(err error) bool {
+	if errors.Is(err, errNoTrackList) {
+		return true
+	}
+	var dbusErr dbus.Error
+	if !errors.As(err, &dbusErr) {
+		return false
+	}
+	switch dbusErr.Name {
+	case "org.freedesktop.DBus.Error.UnknownInterface",
+		"org.freedesktop.DBus.Error.UnknownMethod",
+		"org.freedesktop.DBus.Error.UnknownProperty":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/godbus/dbus/v5"
+)
+
+func TestIsNoTrackListError(t *testing.T) {
+	cases := []struct {
+		err  error
+		want bool
+	}{
+		{errNoTrackList, true},
+		{fmt.Errorf("error getting track list: %w", dbus.Error{Name: "org.freedesktop.DBus.Error.UnknownInterface"}), true},
+		{fmt.Errorf("error getting tracks metadata: %w", dbus.Error{Name: "org.freedesktop.DBus.Error.UnknownMethod"}), true},
+		{fmt.Errorf("error getting HasTrackList: %w", dbus.Error{Name: "org.freedesktop.DBus.Error.UnknownProperty"}), true},
+		{fmt.Errorf("error getting track list: %w", dbus.Error{Name: "org.freedesktop.DBus.Error.NoReply"}), false},
+		{fmt.Errorf("error getting tracks metadata: %w", context.DeadlineExceeded), false},
+	}
+	for _, c := range cases {
+		if got := isNoTrackListError(c.err); got != c.want {
+			t.Errorf("isNoTrackListError(%v) = %v, want %v", c.err, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,305 @@
+package main
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// offline romanization: Hepburn for kana, Revised Romanization for Hangul
+// and Pinyin for Chinese characters. Japanese kanji are kept as they are,
+// reading them needs a dictionary.
+
+// romanizes all lines, Chinese characters are only romanized if the lyrics
+// contain no kana, as they would be kanji otherwise
+func romanizeLines(lines []string) []string {
+	japanese := false
+	for _, line := range lines {
+		for _, r := range line {
+			japanese = japanese || isKana(r)
+		}
+	}
+	result := make([]string, len(lines))
+	for i, line := range lines {
+		romanized := romanizeHangul(romanizeKana(line))
+		if !japanese {
+			romanized = romanizeHan(romanized)
+		}
+		if romanized != line {
+			result[i] = romanized
+		}
+	}
+	return result
+}
+
+// --- kana ---
+
+var kanaRomaji = map[rune]string{
+	'あ': "a", 'い': "i", 'う': "u", 'え': "e", 'お': "o",
+	'か': "ka", 'き': "ki", 'く': "ku", 'け': "ke", 'こ': "ko",
+	'が': "ga", 'ぎ': "gi", 'ぐ': "gu", 'げ': "ge", 'ご': "go",
+	'さ': "sa", 'し': "shi", 'す': "su", 'せ': "se", 'そ': "so",
+	'ざ': "za", 'じ': "ji", 'ず': "zu", 'ぜ': "ze", 'ぞ': "zo",
+	'た': "ta", 'ち': "chi", 'つ': "tsu", 'て': "te", 'と': "to",
+	'だ': "da", 'ぢ': "ji", 'づ': "zu", 'で': "de", 'ど': "do",
+	'な': "na", 'に': "ni", 'ぬ': "nu", 'ね': "ne", 'の': "no",
+	'は': "ha", 'ひ': "hi", 'ふ': "fu", 'へ': "he", 'ほ': "ho",
+	'ば': "ba", 'び': "bi", 'ぶ': "bu", 'べ': "be", 'ぼ': "bo",
+	'ぱ': "pa", 'ぴ': "pi", 'ぷ': "pu", 'ぺ': "pe", 'ぽ': "po",
+	'ま': "ma", 'み': "mi", 'む': "mu", 'め': "me", 'も': "mo",
+	'や': "ya", 'ゆ': "yu", 'よ': "yo",
+	'ら': "ra", 'り': "ri", 'る': "ru", 'れ': "re", 'ろ': "ro",
+	'わ': "wa", 'ゐ': "wi", 'ゑ': "we", 'を': "o", 'ん': "n",
+	'ゔ': "vu",
+}
+
+// small kana that change the preceding one, e.g. きゃ is kya and ふぁ is fa
+var smallKana = map[rune]string{
+	'ぁ': "a", 'ぃ': "i", 'ぅ': "u", 'ぇ': "e", 'ぉ': "o",
+	'ゃ': "ya", 'ゅ': "yu", 'ょ': "yo", 'ゎ': "wa",
+}
+
+func isKana(r rune) bool {
+	return r >= 'ぁ' && r <= 'ゖ' || r >= 'ァ' && r <= 'ヺ' || r == 'ー'
+}
+
+// katakana as hiragana, other runes unchanged
+func toHiragana(r rune) rune {
+	if r >= 'ァ' && r <= 'ヶ' {
+		return r - 0x60
+	}
+	return r
+}
+
+func isVowel(b byte) bool {
+	return strings.IndexByte("aeiou", b) >= 0
+}
+
+func romanizeKana(line string) string {
+	runes := []rune(line)
+	var out []string // romaji of each kana, combined with small kana
+	flush := func(b *strings.Builder) {
+		for i, s := range out {
+			// っ doubles the following consonant, っち is tchi
+			if s == "っ" {
+				if i+1 < len(out) && out[i+1] != "" && !isVowel(out[i+1][0]) {
+					if strings.HasPrefix(out[i+1], "ch") {
+						b.WriteByte('t')
+					} else {
+						b.WriteByte(out[i+1][0])
+					}
+				}
+				continue
+			}
+			// ん before a vowel or y is written n' to keep it apart
+			if s == "n" && i+1 < len(out) && out[i+1] != "" && (isVowel(out[i+1][0]) || out[i+1][0] == 'y') {
+				s = "n'"
+			}
+			b.WriteString(s)
+		}
+		out = out[:0]
+	}
+
+	var b strings.Builder
+	for _, r := range runes {
+		h := toHiragana(r)
+		switch {
+		case h == 'っ':
+			out = append(out, "っ")
+		case h == 'ー':
+			// long vowel mark, repeats the previous vowel
+			if n := len(out); n > 0 && out[n-1] != "" && out[n-1] != "っ" {
+				prev := out[n-1]
+				out[n-1] = prev + prev[len(prev)-1:]
+			}
+		case smallKana[h] != "":
+			small := smallKana[h]
+			n := len(out)
+			if n == 0 || out[n-1] == "っ" || out[n-1] == "n" {
+				out = append(out, small)
+				break
+			}
+			prev := out[n-1]
+			switch {
+			case prev == "u":
+				out[n-1] = "w" + small // うぃ is wi
+			case small[0] == 'y' && (prev == "shi" || prev == "chi" || prev == "ji"):
+				out[n-1] = prev[:len(prev)-1] + small[1:] // しゃ is sha
+			case small[0] == 'y' || isVowel(prev[len(prev)-1]):
+				// きゃ is kya, ふぁ is fa, てぃ is ti
+				out[n-1] = prev[:len(prev)-1] + small
+			default:
+				out[n-1] = prev + small
+			}
+		case kanaRomaji[h] != "":
+			out = append(out, kanaRomaji[h])
+		default:
+			flush(&b)
+			b.WriteRune(r)
+		}
+	}
+	flush(&b)
+	return b.String()
+}
+
+// --- Hangul ---
+
+var (
+	hangulInitials = []string{"g", "kk", "n", "d", "tt", "r", "m", "b", "pp", "s", "ss", "", "j", "jj", "ch", "k", "t", "p", "h"}
+	hangulMedials  = []string{"a", "ae", "ya", "yae", "eo", "e", "yeo", "ye", "o", "wa", "wae", "oe", "yo", "u", "wo", "we", "wi", "yu", "eu", "ui", "i"}
+	// final consonants at the end of a syllable
+	hangulFinals = []string{"", "k", "k", "k", "n", "n", "n", "t", "l", "k", "m", "l", "l", "l", "p", "l", "m", "p", "p", "t", "t", "ng", "t", "t", "k", "t", "p", "t"}
+	// final consonants moved to a following syllable starting with ㅇ
+	hangulLinked = []string{"", "g", "kk", "ks", "n", "nj", "n", "d", "r", "lg", "lm", "lb", "ls", "lt", "lp", "r", "m", "b", "ps", "s", "ss", "ng", "j", "ch", "k", "t", "p", ""}
+)
+
+const (
+	hangulBase  = 0xAC00
+	hangulLast  = 0xD7A3
+	hangulIeung = 11 // ㅇ, a silent initial
+)
+
+func isHangul(r rune) bool {
+	return r >= hangulBase && r <= hangulLast
+}
+
+// Revised Romanization of a line, with the most common sound changes
+// between syllables of a word
+func romanizeHangul(line string) string {
+	var b strings.Builder
+	runes := []rune(line)
+	for i := 0; i < len(runes); {
+		if !isHangul(runes[i]) {
+			b.WriteRune(runes[i])
+			i++
+			continue
+		}
+		j := i
+		for j < len(runes) && isHangul(runes[j]) {
+			j++
+		}
+		b.WriteString(romanizeHangulWord(runes[i:j]))
+		i = j
+	}
+	return b.String()
+}
+
+func romanizeHangulWord(syllables []rune) string {
+	var b strings.Builder
+	initial := ""
+	overridden := false
+	for i, s := range syllables {
+		idx := int(s - hangulBase)
+		ini, med, fin := idx/588, idx%588/28, idx%28
+		if overridden {
+			b.WriteString(initial)
+		} else {
+			b.WriteString(hangulInitials[ini])
+		}
+		b.WriteString(hangulMedials[med])
+
+		next := -1
+		if i+1 < len(syllables) {
+			next = int(syllables[i+1]-hangulBase) / 588
+		}
+		var final string
+		final, initial, overridden = hangulFinal(fin, next)
+		b.WriteString(final)
+	}
+	return b.String()
+}
+
+// the romanization of a final consonant before the initial `next` (-1 at the
+// end of a word), and the initial of the next syllable if it changes
+func hangulFinal(fin, next int) (final, initial string, changed bool) {
+	final = hangulFinals[fin]
+	if fin == 0 || next < 0 {
+		return final, "", false
+	}
+	if next == hangulIeung {
+		return hangulLinked[fin], "", true // 한국어 is hangugeo
+	}
+	switch {
+	case (fin == 27 || fin == 6 || fin == 15) && (next == 0 || next == 3 || next == 12):
+		// ㅎ makes ㄱ, ㄷ and ㅈ aspirated, 좋다 is jota
+		aspirated := map[int]string{0: "k", 3: "t", 12: "ch"}[next]
+		return map[int]string{27: "", 6: "n", 15: "l"}[fin], aspirated, true
+	case next == 2 || next == 6: // ㄴ, ㅁ
+		// nasalization, 합니다 is hamnida
+		switch final {
+		case "k":
+			return "ng", "", false
+		case "t":
+			return "n", "", false
+		case "p":
+			return "m", "", false
+		case "l":
+			if next == 2 {
+				return "l", "l", true // 설날 is seollal
+			}
+		}
+	case next == 5: // ㄹ
+		switch final {
+		case "n", "l":
+			return "l", "l", true // 신라 is silla
+		case "k":
+			return "ng", "n", true
+		case "p":
+			return "m", "n", true
+		case "t":
+			return "n", "n", true
+		default:
+			return final, "n", true // 종로 is jongno
+		}
+	}
+	return final, "", false
+}
+
+// --- Pinyin ---
+
+var (
+	pinyinOnce sync.Once
+	pinyinMap  map[rune]string
+)
+
+// the reading of a Chinese character, see pinyinTable
+func pinyin(r rune) (string, bool) {
+	pinyinOnce.Do(func() {
+		pinyinMap = make(map[rune]string, 21000)
+		for _, line := range strings.Split(pinyinTable, "\n") {
+			reading, chars, ok := strings.Cut(line, " ")
+			if !ok {
+				continue
+			}
+			for _, c := range chars {
+				pinyinMap[c] = reading
+			}
+		}
+	})
+	reading, ok := pinyinMap[r]
+	return reading, ok
+}
+
+// Pinyin with syllables separated by spaces, other runes are kept
+func romanizeHan(line string) string {
+	var b strings.Builder
+	var last rune
+	afterSyllable := false
+	for _, r := range line {
+		if reading, ok := pinyin(r); ok {
+			if unicode.IsLetter(last) || unicode.IsDigit(last) {
+				b.WriteByte(' ')
+			}
+			b.WriteString(reading)
+			last, afterSyllable = 'a', true
+			continue
+		}
+		if afterSyllable && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			b.WriteByte(' ')
+		}
+		afterSyllable = false
+		b.WriteRune(r)
+		last = r
+	}
+	return b.String()
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// SearchResult is a candidate found by a provider's search
+type SearchResult struct {
+	*LyricsData
+	Delta int // length difference to the target track in ms, 0 if unknown
+	score int // lower is better
+}
+
+type searchProvider struct {
+	name      string
+	search    func(ctx context.Context, query string) ([]*SearchResult, error)
+	available func() bool
+}
+
+var searchProviders = []searchProvider{
+	{"lrclib", searchLyricsLrclib, func() bool { return true }},
+	// the search itself works anonymously, but fetching lyrics does not
+	{"spotify", searchLyricsSpotify, func() bool { return SP_DC != "" }},
+}
+
+// queries all available providers at once and ranks the results by how well
+// they match target, which may be nil
+func searchLyrics(ctx context.Context, query string, target *TrackMetadata) []*SearchResult {
+	var (
+		mu      sync.Mutex
+		results []*SearchResult
+	)
+	forEachConcurrent(ctx, searchProviders, len(searchProviders), func(p searchProvider) {
+		if !p.available() {
+			log(fmt.Sprintf("Skipping %s search, not available", p.name))
+			return
+		}
+		found, err := p.search(ctx, query)
+		if err != nil {
+			log(fmt.Sprintf("Error searching %s: %v", p.name, err))
+			return
+		}
+		for _, r := range found {
+			r.postProcess()
+		}
+		mu.Lock()
+		results = append(results, found...)
+		mu.Unlock()
+	})
+	rankResults(results, target)
+	return results
+}
+
+// sorts the results, synced lyrics of a track with the same title, artist
+// and length first
+func rankResults(results []*SearchResult, target *TrackMetadata) {
+	for _, r := range results {
+		r.score = 0
+		if !r.IsLineSynced {
+			r.score += 20
+		}
+		if target == nil {
+			continue
+		}
+		if target.Length > 0 && r.Length > 0 {
+			r.Delta = r.Length - target.Length
+			r.score += min(abs(r.Delta)/1000, 60) // a second off costs a point
+		} else {
+			r.score += 10
+		}
+		if target.Title != "" && normalizeMetadata(r.Title) != normalizeMetadata(target.Title) {
+			r.score += 10
+		}
+		if target.Artist != "" && normalizeArtist(r.Artist) != normalizeArtist(target.Artist) {
+			r.score += 5
+		}
+	}
+	slices.SortStableFunc(results, func(a, b *SearchResult) int {
+		return cmp.Compare(a.score, b.score)
+	})
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// the cache key and metadata of the track to search lyrics for: the given
+// Spotify track or cache key, or the current track if ref is empty
+func searchTarget(cache *Cache, ref string) (string, *TrackMetadata, error) {
+	if ref == "" {
+		meta, err := getTrackMetadata()
+		if err != nil {
+			return "", nil, fmt.Errorf("error getting track metadata: %v", err)
+		}
+		key, err := cache.KeyFor(meta)
+		return key, meta, err
+	}
+
+	key := ref
+	if id, err := parseSpotifyRef(ref, "track"); err == nil {
+		key = id
+	}
+	if rec, err := cache.Load(key); err == nil && rec.Title != "" {
+		return key, &TrackMetadata{Artist: rec.Artist, Title: rec.Title, Album: rec.Album, Length: rec.Length}, nil
+	}
+	if !isSpotifyTrackID(key) {
+		return "", nil, fmt.Errorf("no cache entry %s", key)
+	}
+	meta, err := getSpotifyTrack(key)
+	if err != nil {
+		log(fmt.Sprintf("Error getting metadata of track %s: %v", key, err))
+		meta = &TrackMetadata{MprisID: "spotify:track:" + key}
+	}
+	return key, meta, nil
+}
+
+// stores the chosen result as user-edited lyrics of key
+func pinSearchResult(cacheDir, key string, target *TrackMetadata, r *SearchResult) error {
+	data := *r.LyricsData
+	// the entry describes the target track, not the one that was found
+	if target != nil && target.Title != "" {
+		data.Artist, data.Title, data.Album, data.Length = target.Artist, target.Title, target.Album, target.Length
+	}
+	// keep the offset of the lyrics that are replaced
+	data.Offset = 0
+	if rec, err := NewCache(cacheDir).Load(key); err == nil {
+		data.Offset = rec.Offset
+	}
+	return data.storeUserEdited(cacheDir, key)
+}
+
+// whether stdin is a terminal the user can answer a prompt on
+func isInteractive() bool {
+	info, err := os.Stdin.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+func formatDelta(r *SearchResult, target *TrackMetadata) string {
+	if target == nil || target.Length == 0 || r.Length == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%+.1fs", float64(r.Delta)/1000)
+}
+
+func formatLength(ms int) string {
+	if ms <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d:%02d", ms/60000, ms/1000%60)
+}
+
+// first line with words, to tell results apart
+func firstLyricLine(data *LyricsData) string {
+	for _, line := range data.Lyrics {
+		if words := strings.TrimSpace(line.Words); words != "" {
+			return truncateWidth(words, 40)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// secondaryTransformer provides the secondary text of lyric lines, e.g. a
+// romanization or translation shown below each line
+type secondaryTransformer struct {
+	name string
+	// returns the secondary text of each line, "" for none
+	transform func(ctx context.Context, lines []string) ([]string, error)
+}
+
+var secondaryTransformers = []secondaryTransformer{
+	{"romanize", func(ctx context.Context, lines []string) ([]string, error) { return romanizeLines(lines), nil }},
+	{"translate", translateLines},
+}
+
+func secondaryTransformerNames() []string {
+	names := make([]string, len(secondaryTransformers))
+	for i, t := range secondaryTransformers {
+		names[i] = t.name
+	}
+	return names
+}
+
+func checkSecondary(name string) error {
+	if name != "" && !slices.Contains(secondaryTransformerNames(), name) {
+		return fmt.Errorf("unknown secondary text %q, expected one of %s", name, strings.Join(secondaryTransformerNames(), ", "))
+	}
+	return nil
+}
+
+// a copy of data with the secondary text of every line set by the named
+// transformer, data itself if name is empty
+func withSecondary(ctx context.Context, name string, data *LyricsData) (*LyricsData, error) {
+	if name == "" || data == nil || len(data.Lyrics) == 0 {
+		return data, nil
+	}
+	if err := checkSecondary(name); err != nil {
+		return data, err
+	}
+	t := secondaryTransformers[slices.Index(secondaryTransformerNames(), name)]
+
+	words := make([]string, len(data.Lyrics))
+	for i, line := range data.Lyrics {
+		words[i] = line.Words
+	}
+	secondary, err := t.transform(ctx, words)
+	if err != nil {
+		return data, fmt.Errorf("error getting %s text: %v", name, err)
+	}
+	if len(secondary) != len(words) {
+		return data, fmt.Errorf("error getting %s text: expected %d lines, got %d", name, len(words), len(secondary))
+	}
+	result := *data
+	result.Lyrics = slices.Clone(data.Lyrics)
+	for i := range result.Lyrics {
+		// lines without one keep a translation that came with the lyrics
+		if s := strings.TrimSpace(secondary[i]); s != "" && s != strings.TrimSpace(words[i]) {
+			result.Lyrics[i].Secondary = s
+		}
+	}
+	return &result, nil
+}
+
+type translateRequest struct {
+	Q      []string `json:"q"`
+	Source string   `json:"source"`
+	Target string   `json:"target"`
+	Format string   `json:"format"`
+}
+
+type translateResponse struct {
+	TranslatedText []string `json:"translatedText"`
+	Error          string   `json:"error"`
+}
+
+// translates all lines with one request to a LibreTranslate compatible
+// endpoint, which is usually running locally
+func translateLines(ctx context.Context, lines []string) ([]string, error) {
+	if TRANSLATE_URL == "" {
+		return nil, fmt.Errorf("no translation endpoint set, see --translate-url")
+	}
+	body, err := json.Marshal(translateRequest{
+		Q:      lines,
+		Source: "auto",
+		Target: TRANSLATE_TARGET,
+		Format: "text",
+	})
+	if err != nil {
+		return nil, err
+	}
+	client := &http.Client{Timeout: FETCH_TIMEOUT}
+	req, err := http.NewRequestWithContext(ctx, "POST", TRANSLATE_URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", USER_AGENT_HONEST)
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result translateResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("error decoding response (status %d): %v", resp.StatusCode, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		if result.Error != "" {
+			return nil, fmt.Errorf("translation endpoint returned status code %d: %s", resp.StatusCode, result.Error)
+		}
+		return nil, fmt.Errorf("translation endpoint returned status code: %d", resp.StatusCode)
+	}
+	return result.TranslatedText, nil
+}
@@ -0,0 +1,313 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/godbus/dbus/v5/introspect"
+	"github.com/godbus/dbus/v5/prop"
+)
+
+// listen can own a bus name and export the state of the lyrics, so widgets
+// can subscribe to PropertiesChanged instead of polling the output file
+
+const (
+	lyricsServicePath      = "/io/github/uyanide/SpotifyLyrics"
+	lyricsServiceInterface = "io.github.uyanide.SpotifyLyrics"
+)
+
+// values of the State property
+const (
+	SERVICE_NO_TRACK = "none"
+	SERVICE_FETCHING = "fetching"
+	SERVICE_SYNCED   = "synced"
+	SERVICE_UNSYNCED = "unsynced"
+	SERVICE_404      = "404"
+)
+
+var lyricsServiceIntrospection = introspect.Interface{
+	Name: lyricsServiceInterface,
+	Properties: []introspect.Property{
+		{Name: "CurrentLine", Type: "s", Access: "read"},
+		{Name: "NextLine", Type: "s", Access: "read"},
+		{Name: "Lyrics", Type: "a(xss)", Access: "read"}, // start time in ms, words, secondary text
+		{Name: "Offset", Type: "i", Access: "read"},      // global and per-track, added to the start times
+		{Name: "TrackOffset", Type: "i", Access: "read"}, // per-track only, see SetOffset
+		{Name: "State", Type: "s", Access: "read"},
+	},
+	Methods: []introspect.Method{
+		{Name: "SetOffset", Args: []introspect.Arg{{Name: "ms", Type: "i", Direction: "in"}}},
+		{Name: "Refetch"},
+		{Name: "SeekToLine", Args: []introspect.Arg{{Name: "index", Type: "i", Direction: "in"}}},
+	},
+}
+
+// the State of fetched lyrics, data may be nil
+func serviceState(data *LyricsData) string {
+	switch notifyState(data) {
+	case NOTIFY_FOUND:
+		return SERVICE_SYNCED
+	case NOTIFY_UNSYNCED:
+		return SERVICE_UNSYNCED
+	}
+	return SERVICE_404
+}
+
+// lyricLineStruct is an element of the Lyrics property, (xss)
+type lyricLineStruct struct {
+	StartTimeMs int64
+	Words       string
+	Secondary   string
+}
+
+// LyricsBus exports the state of a LyricsService on the session bus
+type LyricsBus struct {
+	mu      sync.Mutex
+	conn    *dbus.Conn
+	service *LyricsService
+	props   map[string]dbus.Variant
+
+	// of the current track, for the methods
+	meta   *TrackMetadata
+	lyrics []LyricLine
+	offset int // global and per-track
+}
+
+// exports the service and requests busName
+func newLyricsBus(c *dbus.Conn, busName string, service *LyricsService) (*LyricsBus, error) {
+	b := &LyricsBus{
+		conn:    c,
+		service: service,
+		props: map[string]dbus.Variant{
+			"CurrentLine": dbus.MakeVariant(""),
+			"NextLine":    dbus.MakeVariant(""),
+			"Lyrics":      dbus.MakeVariant([]lyricLineStruct{}),
+			"Offset":      dbus.MakeVariant(int32(0)),
+			"TrackOffset": dbus.MakeVariant(int32(0)),
+			"State":       dbus.MakeVariant(SERVICE_NO_TRACK),
+		},
+	}
+	toDBusErr := func(err error) *dbus.Error {
+		if err == nil {
+			return nil
+		}
+		return dbus.MakeFailedError(err)
+	}
+	methods := map[string]any{
+		"SetOffset": func(ms int32) *dbus.Error {
+			return toDBusErr(b.setOffset(int(ms)))
+		},
+		"Refetch": func() *dbus.Error {
+			return toDBusErr(b.refetch())
+		},
+		"SeekToLine": func(index int32) *dbus.Error {
+			return toDBusErr(b.seekToLine(int(index)))
+		},
+	}
+	propMethods := map[string]any{
+		"Get": func(iface, name string) (dbus.Variant, *dbus.Error) {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			v, ok := b.props[name]
+			if iface != lyricsServiceInterface || !ok {
+				return dbus.Variant{}, dbus.MakeFailedError(fmt.Errorf("unknown property %s.%s", iface, name))
+			}
+			return v, nil
+		},
+		"GetAll": func(iface string) (map[string]dbus.Variant, *dbus.Error) {
+			if iface != lyricsServiceInterface {
+				return nil, dbus.MakeFailedError(fmt.Errorf("unknown interface %s", iface))
+			}
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			props := make(map[string]dbus.Variant, len(b.props))
+			for name, v := range b.props {
+				props[name] = v
+			}
+			return props, nil
+		},
+		"Set": func(iface, name string, value dbus.Variant) *dbus.Error {
+			return dbus.MakeFailedError(fmt.Errorf("property %s.%s is read-only", iface, name))
+		},
+	}
+	node := &introspect.Node{
+		Name: lyricsServicePath,
+		Interfaces: []introspect.Interface{
+			prop.IntrospectData,
+			lyricsServiceIntrospection,
+		},
+	}
+
+	if err := c.ExportMethodTable(methods, lyricsServicePath, lyricsServiceInterface); err != nil {
+		return nil, fmt.Errorf("error exporting lyrics interface: %v", err)
+	}
+	if err := c.ExportMethodTable(propMethods, lyricsServicePath, propertiesInterface); err != nil {
+		return nil, fmt.Errorf("error exporting properties interface: %v", err)
+	}
+	if err := c.Export(introspect.NewIntrospectable(node), lyricsServicePath, "org.freedesktop.DBus.Introspectable"); err != nil {
+		return nil, fmt.Errorf("error exporting introspection: %v", err)
+	}
+	reply, err := c.RequestName(busName, dbus.NameFlagDoNotQueue)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting bus name %s: %v", busName, err)
+	}
+	if reply != dbus.RequestNameReplyPrimaryOwner {
+		return nil, fmt.Errorf("bus name %s already taken", busName)
+	}
+	return b, nil
+}
+
+// the lyrics of the current track changed, data may be nil
+func (b *LyricsBus) SetTrack(meta *TrackMetadata, data *LyricsData, state string) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.meta, b.lyrics = meta, nil
+	lines := []lyricLineStruct{}
+	trackOffset := 0
+	if data != nil && !data.IsError {
+		b.lyrics = data.Lyrics
+		trackOffset = data.Offset
+		for _, line := range data.Lyrics {
+			words, secondary, _ := b.service.Filter.FilterLine(line.Words, line.Secondary)
+			lines = append(lines, lyricLineStruct{int64(line.StartTimeMs), words, secondary})
+		}
+	}
+	// the global offset as of the last position, SetPosition keeps it current
+	b.offset = b.service.currOffset + trackOffset
+	b.set(map[string]any{
+		"Lyrics":      lines,
+		"Offset":      int32(b.offset),
+		"TrackOffset": int32(trackOffset),
+		"State":       state,
+		"CurrentLine": "",
+		"NextLine":    "",
+	})
+}
+
+// the current line changed, idx is the index of the next line in the lyrics
+// passed to SetTrack and offset the global and per-track one
+func (b *LyricsBus) SetPosition(next int, offset int) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.offset = offset
+	line := func(i int) string {
+		if i < 0 || i >= len(b.lyrics) {
+			return ""
+		}
+		words, _, _ := b.service.Filter.FilterLine(b.lyrics[i].Words, b.lyrics[i].Secondary)
+		return words
+	}
+	b.set(map[string]any{
+		"CurrentLine": line(next - 1),
+		"NextLine":    line(next),
+		"Offset":      int32(offset),
+	})
+}
+
+// the global or per-track offset changed without changing the current line
+func (b *LyricsBus) UpdateOffset(offset int) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.offset = offset
+	b.set(map[string]any{"Offset": int32(offset)})
+}
+
+// b.mu must be held, emits PropertiesChanged for the values that differ
+func (b *LyricsBus) set(values map[string]any) {
+	changed := make(map[string]dbus.Variant)
+	for name, value := range values {
+		v := dbus.MakeVariant(value)
+		if old, ok := b.props[name]; ok && old.String() == v.String() {
+			continue
+		}
+		b.props[name] = v
+		changed[name] = v
+	}
+	if len(changed) == 0 {
+		return
+	}
+	if err := b.conn.Emit(lyricsServicePath, propertiesInterface+".PropertiesChanged", lyricsServiceInterface, changed, []string{}); err != nil {
+		log(fmt.Sprintf("Error emitting PropertiesChanged: %v", err))
+	}
+}
+
+// the cache key of the current track
+func (b *LyricsBus) key() (string, error) {
+	b.mu.Lock()
+	meta := b.meta
+	b.mu.Unlock()
+	if meta == nil {
+		return "", fmt.Errorf("no track")
+	}
+	return NewCache(b.service.CacheDir).KeyFor(meta)
+}
+
+// sets the per-track offset, the listener reloads the track on its own
+func (b *LyricsBus) setOffset(ms int) error {
+	key, err := b.key()
+	if err != nil {
+		return err
+	}
+	_, err = setTrackOffset(b.service.CacheDir, key, ms, false)
+	return err
+}
+
+// drops the cached lyrics of the current track and fetches them again,
+// unless they were edited by hand. The per-track offset is kept.
+func (b *LyricsBus) refetch() error {
+	key, err := b.key()
+	if err != nil {
+		return err
+	}
+	cache := NewCache(b.service.CacheDir)
+	if rec, err := cache.Load(key); err == nil && rec.UserEdited {
+		return fmt.Errorf("lyrics of track ID %s were edited by hand, remove them with clear first", key)
+	}
+	// expired instead of removed, which would drop the per-track offset
+	err = cache.Update(key, func(rec *CacheRecord) error {
+		*rec = CacheRecord{
+			Version: rec.Version,
+			TrackID: rec.TrackID,
+			Artist:  rec.Artist,
+			Title:   rec.Title,
+			Album:   rec.Album,
+			Length:  rec.Length,
+			State:   "error",
+			Offset:  rec.Offset,
+		}
+		return nil
+	})
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	log(fmt.Sprintf("Refetching lyrics of track ID %s", key))
+	b.service.reload.Store(true)
+	return nil
+}
+
+// seeks to the start of the line with the given index of Lyrics
+func (b *LyricsBus) seekToLine(idx int) error {
+	b.mu.Lock()
+	lyrics, offset := slices.Clone(b.lyrics), b.offset
+	synced := b.props["State"].Value() == SERVICE_SYNCED
+	b.mu.Unlock()
+	if !synced {
+		return fmt.Errorf("lyrics are not synchronized")
+	}
+	if idx < 0 || idx >= len(lyrics) {
+		return fmt.Errorf("index %d out of range (0-%d)", idx, len(lyrics)-1)
+	}
+	return seekToLine(lyrics, idx, offset)
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+)
+
+// the offsets on the bus follow reloads (as on SIGUSR1), changes of the
+// global offset and survive Refetch
+func TestLyricsBusOffsets(t *testing.T) {
+	addr := privateBus(t)
+	server, err := dbus.Connect(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+	client, err := dbus.Connect(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	lrclib := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"syncedLyrics":"[00:01.00]fetched one\n[00:04.00]fetched two"}`))
+	}))
+	defer lrclib.Close()
+	oldURL := LRCLIB_API_URL
+	LRCLIB_API_URL = lrclib.URL
+	defer func() { LRCLIB_API_URL = oldURL }()
+
+	cache := NewCache(filepath.Join(t.TempDir(), "cache"))
+	cached := &LyricsData{
+		TrackID:      "cached",
+		Artist:       "Artist",
+		Title:        "Song",
+		Length:       180000,
+		IsLineSynced: true,
+		Offset:       200,
+		Lyrics:       []LyricLine{{StartTimeMs: 1000, Words: "cached one"}},
+	}
+	if err := cache.Store(cached.TrackID, NewCacheRecord(cached)); err != nil {
+		t.Fatal(err)
+	}
+	if err := cache.AddAliases(cached.TrackID, cached.aliases()...); err != nil {
+		t.Fatal(err)
+	}
+
+	p := NewFakePlayer()
+	p.AddTrack(fakeTrack{ID: "/test/track/1", Length: 180000000, Artist: []string{"Artist"}, Title: "Song"})
+	player = p
+	defer closeDBus()
+
+	l := &LyricsService{OutputPath: filepath.Join(t.TempDir(), "lyrics.txt"), CacheDir: cache.dir, Offset: 100}
+	if err := l.initDisplay(); err != nil {
+		t.Fatal(err)
+	}
+	busName := lyricsServiceInterface + ".Test"
+	if l.bus, err = newLyricsBus(server, busName, l); err != nil {
+		t.Fatal(err)
+	}
+	obj := client.Object(busName, lyricsServicePath)
+	get := func(name string) any {
+		t.Helper()
+		v, err := obj.GetProperty(lyricsServiceInterface + "." + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v.Value()
+	}
+	// runs proc until the offsets on the bus are the wanted ones
+	expect := func(offset, trackOffset int32) {
+		t.Helper()
+		var gotOffset, gotTrackOffset any
+		for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+			l.proc()
+			gotOffset, gotTrackOffset = get("Offset"), get("TrackOffset")
+			if gotOffset == offset && gotTrackOffset == trackOffset && !l.fetching {
+				return
+			}
+		}
+		t.Fatalf("Offset is %v and TrackOffset %v, want %d and %d", gotOffset, gotTrackOffset, offset, trackOffset)
+	}
+
+	expect(300, 200)
+	if _, err := setTrackOffset(cache.dir, cached.TrackID, 500, false); err != nil {
+		t.Fatal(err)
+	}
+	l.reload.Store(true)
+	expect(600, 500)
+	l.Offset = -100
+	expect(400, 500)
+
+	if err := obj.Call(lyricsServiceInterface+".Refetch", 0).Err; err != nil {
+		t.Fatal(err)
+	}
+	expect(400, 500)
+	if lyrics := get("Lyrics").([][]any); len(lyrics) != 2 || lyrics[0][1] != "fetched one" {
+		t.Errorf("Lyrics are %v, want the fetched ones", lyrics)
+	}
+	rec, err := cache.Load(cached.TrackID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Offset != 500 || rec.Provider != "lrclib" {
+		t.Errorf("refetched record has offset %d from %q, want 500 from lrclib", rec.Offset, rec.Provider)
+	}
+}
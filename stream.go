@@ -0,0 +1,192 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// stream modes of an output
+const (
+	STREAM_FRAMES = "frames" // every frame as a JSON object
+	STREAM_EVENTS = "events" // track changes, line changes and messages as JSON objects
+)
+
+func checkStream(mode string) error {
+	if mode != "" && mode != STREAM_FRAMES && mode != STREAM_EVENTS {
+		return fmt.Errorf("unknown stream mode %q, expected %s or %s", mode, STREAM_FRAMES, STREAM_EVENTS)
+	}
+	return nil
+}
+
+// streamFrame is written for every frame in STREAM_FRAMES mode
+type streamFrame struct {
+	Text string   `json:"text"` // the frame as it would be written to a file, see Display.render
+	Rows []string `json:"rows"`
+}
+
+// streamEvent is written in STREAM_EVENTS mode
+type streamEvent struct {
+	Event     string `json:"event"` // track, line, message or clear
+	Title     string `json:"title,omitempty"`
+	Index     *int   `json:"index,omitempty"` // of the line, 0 is the title before the first line
+	Text      string `json:"text,omitempty"`
+	Secondary string `json:"secondary,omitempty"`
+}
+
+// streamWriter writes newline-delimited messages to stdout or a named pipe.
+// Readers of the pipe may come and go: without a reader messages are
+// dropped, and a new reader first gets the header and the latest message.
+// Messages are written in the background, so a slow reader never holds up
+// the sender.
+type streamWriter struct {
+	mu     sync.Mutex
+	path   string
+	file   *os.File // nil while no reader is connected
+	stdout bool
+	header []byte // e.g. the track event the latest message belongs to
+	last   []byte
+	queue  chan []byte // of messages to write, see run
+}
+
+var ignoreSigpipe sync.Once
+
+func newStreamWriter(path string) (*streamWriter, error) {
+	// without this, writing to a closed stdout kills the process
+	ignoreSigpipe.Do(func() { signal.Ignore(syscall.SIGPIPE) })
+
+	w := &streamWriter{path: path, queue: make(chan []byte, STREAM_QUEUE_SIZE)}
+	if path == "-" || path == "/dev/stdout" {
+		w.stdout, w.file = true, os.Stdout
+		go w.run()
+		return w, nil
+	}
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		if err := syscall.Mkfifo(path, 0644); err != nil {
+			return nil, fmt.Errorf("error creating named pipe %s: %v", path, err)
+		}
+	} else if err != nil {
+		return nil, err
+	} else if info.Mode()&os.ModeNamedPipe == 0 {
+		return nil, fmt.Errorf("%s exists and is not a named pipe", path)
+	}
+	go w.run()
+	go w.reconnect()
+	return w, nil
+}
+
+// opens the pipe whenever there is no reader, without blocking until one
+// shows up
+func (w *streamWriter) reconnect() {
+	for {
+		w.mu.Lock()
+		if w.file == nil {
+			file, err := os.OpenFile(w.path, os.O_WRONLY|syscall.O_NONBLOCK, 0)
+			if err == nil {
+				log(fmt.Sprintf("Reader connected to %s", w.path))
+				w.file = file
+				for _, data := range [][]byte{w.header, w.last} {
+					if data != nil {
+						w.enqueue(data)
+					}
+				}
+			} else if !errors.Is(err, syscall.ENXIO) { // ENXIO: no reader yet
+				log(fmt.Sprintf("Error opening %s: %v", w.path, err))
+			}
+		}
+		w.mu.Unlock()
+		time.Sleep(time.Duration(STREAM_RECONNECT_INTERVAL_MS) * time.Millisecond)
+	}
+}
+
+// writes v as a line of JSON
+func (w *streamWriter) Send(v any) {
+	w.send(v, false)
+}
+
+// like Send, but v is also replayed to new readers before the latest message
+// until the next header
+func (w *streamWriter) SendHeader(v any) {
+	w.send(v, true)
+}
+
+func (w *streamWriter) send(v any, header bool) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf) // adds the newline
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		log(fmt.Sprintf("Error encoding stream message: %v", err))
+		return
+	}
+	data := buf.Bytes()
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if header {
+		w.header, w.last = data, nil
+	} else {
+		w.last = data
+	}
+	if w.file != nil {
+		w.enqueue(data)
+	}
+}
+
+// never blocks, the message is dropped if the queue is full
+func (w *streamWriter) enqueue(data []byte) {
+	select {
+	case w.queue <- data:
+	default:
+		log(fmt.Sprintf("Reader of %s is too slow, dropped a message", w.path))
+	}
+}
+
+// writes the queued messages
+func (w *streamWriter) run() {
+	for data := range w.queue {
+		w.write(data)
+	}
+}
+
+func (w *streamWriter) write(data []byte) {
+	w.mu.Lock()
+	file := w.file
+	w.mu.Unlock()
+	if file == nil {
+		return // the reader disconnected since
+	}
+	// Go's poller waits for the pipe to become writable instead of returning
+	// EAGAIN. Terminals and the like can't have a deadline and just block.
+	file.SetWriteDeadline(time.Now().Add(time.Duration(STREAM_WRITE_TIMEOUT_MS) * time.Millisecond))
+	n, err := file.Write(data)
+	if errors.Is(err, os.ErrDeadlineExceeded) && n > 0 {
+		// finish the line, a torn one would garble the next message as well
+		file.SetWriteDeadline(time.Time{})
+		_, err = file.Write(data[n:])
+	}
+	switch {
+	case err == nil:
+	case errors.Is(err, os.ErrDeadlineExceeded):
+		log(fmt.Sprintf("Reader of %s is too slow, dropped a message", w.path))
+	case errors.Is(err, syscall.EPIPE):
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		if w.stdout {
+			log("Stdout was closed, dropping further output")
+		} else {
+			log(fmt.Sprintf("Reader of %s disconnected", w.path))
+			file.Close()
+		}
+		if w.file == file {
+			w.file = nil
+		}
+	default:
+		log(fmt.Sprintf("Error writing to %s: %v", w.path, err))
+	}
+}
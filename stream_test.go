@@ -0,0 +1,62 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// a reader that doesn't keep up must not block the sender, and what it
+// reads later are whole messages
+func TestStreamWriterSlowReader(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stream")
+	w, err := newStreamWriter(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader, err := os.OpenFile(path, os.O_RDONLY|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		w.mu.Lock()
+		connected := w.file != nil
+		w.mu.Unlock()
+		if connected {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the reader was not connected")
+		}
+	}
+
+	// far more than the pipe buffer holds
+	start := time.Now()
+	for range 500 {
+		w.Send(streamFrame{Text: strings.Repeat("x", 3000)})
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("sending took %v, the sender was blocked", elapsed)
+	}
+
+	reader.SetReadDeadline(time.Now().Add(time.Second))
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(nil, 1<<20)
+	lines := 0
+	for scanner.Scan() {
+		var frame streamFrame
+		if err := json.Unmarshal(scanner.Bytes(), &frame); err != nil || len(frame.Text) != 3000 {
+			t.Fatalf("line %d is not a whole message: %v", lines+1, err)
+		}
+		lines++
+	}
+	if lines == 0 {
+		t.Error("no messages were read")
+	}
+}
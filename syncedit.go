@@ -0,0 +1,291 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+const syncEditHelp = "space stamp  ↑↓ select  ←→ ±100ms  <> ±1s  u unstamp  r replay line  b/f ∓5s  p pause  s save  q quit"
+
+// SyncEditor stamps the lines of the current track while it plays
+type SyncEditor struct {
+	cacheDir string
+	key      string
+	trackID  string // mpris:trackid when the editor was started
+	title    string
+	data     LyricsData // the lyrics being edited
+	stamped  []bool
+	offset   int // global offset of listen, see --offset and --offset-file
+
+	cur        int // selected line
+	pos        int // player position in ms
+	dirty      bool
+	wrongTrack bool   // the player switched to another track
+	status     string // message in the last row
+	armed      string // key that has to be pressed again to confirm
+}
+
+// offset is the global offset listen is run with, so that lines stamped
+// while listening to it line up
+func NewSyncEditor(cacheDir string, offset int) (*SyncEditor, error) {
+	trackID, err := getTrackID()
+	if err != nil {
+		return nil, err
+	}
+	key, err := getCurrentCacheKey(cacheDir)
+	if err != nil {
+		return nil, fmt.Errorf("error getting cache key: %v", err)
+	}
+	data, err := fetchLyrics(cacheDir)
+	if err != nil {
+		return nil, err
+	}
+	if data.IsError || len(data.Lyrics) == 0 {
+		return nil, fmt.Errorf("no lyrics to sync for track ID %s, try search or edit first", key)
+	}
+
+	e := &SyncEditor{
+		cacheDir: cacheDir,
+		key:      key,
+		trackID:  trackID,
+		title:    getTrackDisplayTitle(),
+		data:     *data,
+		stamped:  make([]bool, len(data.Lyrics)),
+		offset:   offset,
+	}
+	e.data.Lyrics = slices.Clone(data.Lyrics)
+	if data.IsLineSynced {
+		// fixing existing timings
+		sortLyrics(e.data.Lyrics)
+		for i := range e.stamped {
+			e.stamped[i] = true
+		}
+		e.status = "Lyrics are synced already, select lines to fix them"
+	} else {
+		e.status = "Press space whenever a line starts"
+	}
+	return e, nil
+}
+
+func (e *SyncEditor) run() error {
+	restore, err := makeRaw(os.Stdin)
+	if err != nil {
+		return err
+	}
+	defer restore()
+	fmt.Print("\033[?1049h\033[?25l") // alternate screen, hide cursor
+	defer fmt.Print("\033[?25h\033[?1049l")
+
+	keys := readKeys(os.Stdin)
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		e.update()
+		e.render()
+		select {
+		case key, ok := <-keys:
+			if !ok {
+				return nil
+			}
+			e.update()
+			if e.handleKey(key) {
+				return nil
+			}
+		case <-ticker.C:
+		}
+	}
+}
+
+// polls the player
+func (e *SyncEditor) update() {
+	pos, err := getPosition()
+	if err != nil {
+		e.status = err.Error()
+		return
+	}
+	e.pos = pos
+	if trackID, err := getTrackID(); err == nil && trackID != e.trackID && !e.wrongTrack {
+		e.wrongTrack = true
+		e.status = "The player switched to another track, go back to stamp lines"
+	} else if trackID == e.trackID && e.wrongTrack {
+		e.wrongTrack = false
+		e.status = "Back on the right track"
+	}
+}
+
+// lyric time of the current position, without the global offset and the
+// per-track one, which is kept on save
+func (e *SyncEditor) now() int {
+	return e.pos - e.offset - e.data.Offset
+}
+
+// whether the editor should quit
+func (e *SyncEditor) handleKey(key string) bool {
+	armed := e.armed
+	e.armed = ""
+	n := len(e.data.Lyrics)
+	line := &e.data.Lyrics[e.cur]
+
+	switch key {
+	case " ", "\r", "\n":
+		if e.wrongTrack {
+			e.status = "Not stamping, the player is playing another track"
+			break
+		}
+		line.StartTimeMs = max(e.now(), 0)
+		e.stamped[e.cur] = true
+		e.dirty = true
+		e.status = fmt.Sprintf("Stamped line %d at %s", e.cur+1, formatLyricTime(line.StartTimeMs))
+		e.cur = min(e.cur+1, n-1)
+	case "up", "k":
+		e.cur = max(e.cur-1, 0)
+	case "down", "j":
+		e.cur = min(e.cur+1, n-1)
+	case "pgup":
+		e.cur = max(e.cur-10, 0)
+	case "pgdown":
+		e.cur = min(e.cur+10, n-1)
+	case "home", "g":
+		e.cur = 0
+	case "end", "G":
+		e.cur = n - 1
+	case "left", "h", "right", "l", "<", ">":
+		if !e.stamped[e.cur] {
+			e.status = "Stamp the line before nudging it"
+			break
+		}
+		delta := map[string]int{"left": -100, "h": -100, "right": 100, "l": 100, "<": -1000, ">": 1000}[key]
+		line.StartTimeMs = max(line.StartTimeMs+delta, 0)
+		e.dirty = true
+		e.status = fmt.Sprintf("Moved line %d to %s", e.cur+1, formatLyricTime(line.StartTimeMs))
+	case "u":
+		if e.stamped[e.cur] {
+			e.stamped[e.cur] = false
+			e.dirty = true
+		}
+	case "r":
+		// replay from shortly before the selected (or last stamped) line
+		target := 0
+		for i := e.cur; i >= 0; i-- {
+			if e.stamped[i] {
+				target = e.data.Lyrics[i].StartTimeMs
+				break
+			}
+		}
+		if err := setPosition(max(target+e.offset+e.data.Offset-SYNC_EDIT_REPLAY_LEAD_MS, 0)); err != nil {
+			e.status = err.Error()
+		}
+	case "b", "f":
+		offset := map[string]int{"b": -5000, "f": 5000}[key]
+		if err := seek(offset); err != nil {
+			e.status = err.Error()
+		}
+	case "p":
+		if err := callPlayer("PlayPause"); err != nil {
+			e.status = err.Error()
+		}
+	case "s":
+		missing := 0
+		for _, s := range e.stamped {
+			if !s {
+				missing++
+			}
+		}
+		if missing > 0 && armed != "s" {
+			e.armed = "s"
+			e.status = fmt.Sprintf("%d lines are not stamped, press s again to save them at the time of the line before", missing)
+			break
+		}
+		if err := e.save(); err != nil {
+			e.status = err.Error()
+			break
+		}
+		e.dirty = false
+		e.status = fmt.Sprintf("Saved as user-edited lyrics of %s", e.key)
+	case "q", "\x03": // Ctrl-C, signals are off in raw mode
+		if !e.dirty || armed == "q" {
+			return true
+		}
+		e.armed = "q"
+		e.status = "There are unsaved changes, press q again to quit anyway"
+	}
+	return false
+}
+
+func (e *SyncEditor) save() error {
+	data := e.data
+	data.Lyrics = slices.Clone(e.data.Lyrics)
+	prev := 0
+	for i := range data.Lyrics {
+		if e.stamped[i] {
+			prev = data.Lyrics[i].StartTimeMs
+		} else {
+			data.Lyrics[i].StartTimeMs = prev
+		}
+	}
+	sortLyrics(data.Lyrics)
+	data.IsLineSynced = true
+	return data.storeUserEdited(e.cacheDir, e.key)
+}
+
+// index of the last stamped line that has started, -1 if none
+func (e *SyncEditor) playing() int {
+	idx := -1
+	for i, line := range e.data.Lyrics {
+		if e.stamped[i] && line.StartTimeMs <= e.now() && (idx < 0 || line.StartTimeMs >= e.data.Lyrics[idx].StartTimeMs) {
+			idx = i
+		}
+	}
+	return idx
+}
+
+func (e *SyncEditor) render() {
+	cols, rows := terminalSize(os.Stdout)
+	var b strings.Builder
+	b.WriteString("\033[H\033[2J")
+	fmt.Fprintf(&b, "%s\n", truncateWidth(fmt.Sprintf("%s  [%s]", e.title, formatLyricTime(e.now())), cols))
+	b.WriteString("\n")
+
+	// keep the selected line in the middle
+	height := max(rows-5, 1)
+	start := min(max(e.cur-height/2, 0), max(len(e.data.Lyrics)-height, 0))
+	playing := e.playing()
+	for i := start; i < min(start+height, len(e.data.Lyrics)); i++ {
+		marker := "  "
+		if i == e.cur {
+			marker = "> "
+		}
+		stamp := "--:--.--"
+		if e.stamped[i] {
+			stamp = formatLyricTime(e.data.Lyrics[i].StartTimeMs)
+		}
+		note := " "
+		if i == playing {
+			note = "♪"
+		}
+		row := truncateWidth(fmt.Sprintf("%s%s %s %s", marker, stamp, note, e.data.Lyrics[i].Words), cols)
+		if i == e.cur {
+			row = "\033[7m" + row + "\033[0m" // reverse video
+		}
+		b.WriteString(row + "\n")
+	}
+	for i := min(start+height, len(e.data.Lyrics)) - start; i < height; i++ {
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n" + truncateWidth(syncEditHelp, cols) + "\n")
+	b.WriteString(truncateWidth(e.status, cols))
+	os.Stdout.WriteString(b.String())
+}
+
+// mm:ss.xx as in LRC files
+func formatLyricTime(ms int) string {
+	sign := ""
+	if ms < 0 {
+		sign, ms = "-", -ms
+	}
+	return fmt.Sprintf("%s%02d:%02d.%02d", sign, ms/60000, ms/1000%60, ms%1000/10)
+}
@@ -0,0 +1,15 @@
+package main
+
+import "testing"
+
+// lines are stamped without the global and the per-track offset, which
+// listen adds back when showing them
+func TestSyncEditorNow(t *testing.T) {
+	e := &SyncEditor{pos: 5000, offset: 300, data: LyricsData{Offset: -200}}
+	if now := e.now(); now != 4900 {
+		t.Errorf("now() = %d, want 4900", now)
+	}
+	if next := nextLineIndex([]LyricLine{{StartTimeMs: e.now()}}, e.pos, e.offset+e.data.Offset); next != 1 {
+		t.Errorf("a line stamped now does not start at the position in listen")
+	}
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// minimal terminal handling for the interactive commands, Linux only like
+// the rest of this tool
+
+func ioctl(fd uintptr, req uintptr, arg unsafe.Pointer) error {
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, req, uintptr(arg)); errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// puts the terminal into raw mode, call the returned function to restore it.
+// Output processing is kept, so "\n" still starts a new line.
+func makeRaw(f *os.File) (func(), error) {
+	var old syscall.Termios
+	if err := ioctl(f.Fd(), syscall.TCGETS, unsafe.Pointer(&old)); err != nil {
+		return nil, fmt.Errorf("not a terminal: %v", err)
+	}
+	raw := old
+	raw.Iflag &^= syscall.ICRNL | syscall.IXON | syscall.BRKINT | syscall.ISTRIP
+	raw.Lflag &^= syscall.ECHO | syscall.ICANON | syscall.ISIG | syscall.IEXTEN
+	raw.Cc[syscall.VMIN] = 1
+	raw.Cc[syscall.VTIME] = 0
+	if err := ioctl(f.Fd(), syscall.TCSETS, unsafe.Pointer(&raw)); err != nil {
+		return nil, fmt.Errorf("error setting raw mode: %v", err)
+	}
+	return func() {
+		ioctl(f.Fd(), syscall.TCSETS, unsafe.Pointer(&old))
+	}, nil
+}
+
+// the size of the terminal, 80x24 if unknown
+func terminalSize(f *os.File) (cols, rows int) {
+	var ws struct{ Row, Col, Xpixel, Ypixel uint16 }
+	if err := ioctl(f.Fd(), syscall.TIOCGWINSZ, unsafe.Pointer(&ws)); err != nil || ws.Col == 0 || ws.Row == 0 {
+		return 80, 24
+	}
+	return int(ws.Col), int(ws.Row)
+}
+
+// key names of escape sequences, other keys are passed on as they are
+var escapeKeys = map[string]string{
+	"\x1b[A":  "up",
+	"\x1b[B":  "down",
+	"\x1b[C":  "right",
+	"\x1b[D":  "left",
+	"\x1b[5~": "pgup",
+	"\x1b[6~": "pgdown",
+	"\x1b[H":  "home",
+	"\x1b[F":  "end",
+}
+
+// reads key presses from f until it is closed
+func readKeys(f *os.File) <-chan string {
+	keys := make(chan string)
+	go func() {
+		defer close(keys)
+		buf := make([]byte, 16)
+		for {
+			n, err := f.Read(buf)
+			if err != nil {
+				return
+			}
+			s := string(buf[:n])
+			if name, ok := escapeKeys[s]; ok {
+				keys <- name
+				continue
+			}
+			// several keys might arrive at once, e.g. when pasting
+			for _, r := range s {
+				keys <- string(r)
+			}
+		}
+	}()
+	return keys
+}
@@ -1,14 +1,20 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
+	"sync"
 	"syscall"
+	"time"
 )
 
 func acquireLock(lockFile string) (*os.File, error) {
-	file, err := os.OpenFile(lockFile, os.O_CREATE|os.O_TRUNC|os.O_RDWR, 0644)
+	// not truncated before the lock is held, the PID in it is read by notifyListener
+	file, err := os.OpenFile(lockFile, os.O_CREATE|os.O_RDWR, 0644)
 	if err != nil {
 		return nil, err
 	}
@@ -18,6 +24,10 @@ func acquireLock(lockFile string) (*os.File, error) {
 		return nil, fmt.Errorf("another instance is already running")
 	}
 
+	if err := file.Truncate(0); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("error truncating lock file: %v", err)
+	}
 	fmt.Fprintf(file, "%d", os.Getpid())
 	file.Sync()
 
@@ -38,3 +48,114 @@ func getCacheDir() (string, error) {
 	}
 	return dir, nil
 }
+
+// writes to a temp file in the same directory and renames it over path,
+// so that readers see either the old or the new content, never a mix
+func writeFileAtomic(path string, content []byte, perm os.FileMode) error {
+	// Dir is "." for a bare file name, while an empty dir would put the temp
+	// file into $TMPDIR and the rename could cross file systems
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // no-op after a successful rename
+
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// like writeFileAtomic for regular files, falls back to a plain write for
+// anything that can't be replaced by renaming (terminals, pipes, devices).
+// Symlinks such as /dev/stdout are written through, renaming would replace
+// the link itself.
+func writeOutputFile(path string, content []byte) error {
+	if info, err := os.Lstat(path); err == nil && !info.Mode().IsRegular() {
+		return os.WriteFile(path, content, 0644)
+	}
+	return writeFileAtomic(path, content, 0644)
+}
+
+// takes a flock on path (created if missing), call the returned function to release it
+func flockFile(path string, exclusive bool) (func(), error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, err
+	}
+	how := syscall.LOCK_SH
+	if exclusive {
+		how = syscall.LOCK_EX
+	}
+	if err := syscall.Flock(int(file.Fd()), how); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return func() {
+		syscall.Flock(int(file.Fd()), syscall.LOCK_UN)
+		file.Close()
+	}, nil
+}
+
+// rateLimiter spaces out requests to a provider, it is shared by all
+// goroutines fetching from that provider
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func newRateLimiter(intervalMs int) *rateLimiter {
+	return &rateLimiter{interval: time.Duration(intervalMs) * time.Millisecond}
+}
+
+// blocks until the next request may be sent or ctx is done
+func (r *rateLimiter) Wait(ctx context.Context) error {
+	r.mu.Lock()
+	now := time.Now()
+	wait := max(r.next.Sub(now), 0)
+	r.next = now.Add(wait + r.interval)
+	r.mu.Unlock()
+	return sleepCtx(ctx, wait)
+}
+
+// holds back all further requests for at least d, e.g. after a 429
+func (r *rateLimiter) Backoff(d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if until := time.Now().Add(d); until.After(r.next) {
+		r.next = until
+	}
+}
+
+// the delay requested by a 429 response, defaults to RETRY_AFTER_DEFAULT_SEC
+func retryAfter(resp *http.Response) time.Duration {
+	if sec, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && sec > 0 {
+		return time.Duration(sec) * time.Second
+	}
+	return time.Duration(RETRY_AFTER_DEFAULT_SEC) * time.Second
+}
+
+// like time.Sleep, but returns early with ctx.Err() once ctx is done
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+// a bare file name is written next to it, not via $TMPDIR
+func TestWriteFileAtomicRelative(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+	t.Setenv("TMPDIR", filepath.Join(dir, "missing"))
+
+	if err := writeFileAtomic("out.txt", []byte("content"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	content, err := os.ReadFile(filepath.Join(dir, "out.txt"))
+	if err != nil || string(content) != "content" {
+		t.Fatalf("read %q, %v", content, err)
+	}
+}
+
+// a second instance must not wipe the PID of the one holding the lock
+func TestAcquireLockKeepsPID(t *testing.T) {
+	lockFile := filepath.Join(t.TempDir(), "lock")
+	if err := os.WriteFile(lockFile, []byte("999999999"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	file, err := acquireLock(lockFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	if _, err := acquireLock(lockFile); err == nil {
+		t.Fatal("lock acquired twice")
+	}
+	content, err := os.ReadFile(lockFile)
+	if err != nil || string(content) != strconv.Itoa(os.Getpid()) {
+		t.Fatalf("lock file contains %q, %v, want the PID", content, err)
+	}
+}
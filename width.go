@@ -0,0 +1,105 @@
+package main
+
+import (
+	"strings"
+	"unicode"
+)
+
+// East Asian Wide (W) and Fullwidth (F) ranges, good enough for lyrics
+var wideRanges = [][2]rune{
+	{0x1100, 0x115F},   // Hangul Jamo
+	{0x2E80, 0x303E},   // CJK Radicals .. CJK Symbols and Punctuation
+	{0x3041, 0x33FF},   // Hiragana .. CJK Compatibility
+	{0x3400, 0x4DBF},   // CJK Unified Ideographs Extension A
+	{0x4E00, 0x9FFF},   // CJK Unified Ideographs
+	{0xA000, 0xA4CF},   // Yi
+	{0xA960, 0xA97F},   // Hangul Jamo Extended-A
+	{0xAC00, 0xD7A3},   // Hangul Syllables
+	{0xF900, 0xFAFF},   // CJK Compatibility Ideographs
+	{0xFE10, 0xFE19},   // Vertical Forms
+	{0xFE30, 0xFE6F},   // CJK Compatibility Forms, Small Form Variants
+	{0xFF00, 0xFF60},   // Fullwidth Forms
+	{0xFFE0, 0xFFE6},   // Fullwidth Signs
+	{0x1F300, 0x1F64F}, // Misc Symbols and Pictographs, Emoticons
+	{0x1F900, 0x1F9FF}, // Supplemental Symbols and Pictographs
+	{0x20000, 0x2FFFD}, // CJK Extension B ..
+	{0x30000, 0x3FFFD}, // CJK Extension G ..
+}
+
+// number of terminal columns a rune occupies
+func runeWidth(r rune) int {
+	if r == 0x200B || unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf) {
+		return 0
+	}
+	if r < 0x1100 {
+		return 1
+	}
+	for _, rg := range wideRanges {
+		if r < rg[0] {
+			break
+		}
+		if r <= rg[1] {
+			return 2
+		}
+	}
+	return 1
+}
+
+func stringWidth(s string) int {
+	w := 0
+	for _, r := range s {
+		w += runeWidth(r)
+	}
+	return w
+}
+
+// cuts s to at most `width` columns, ending with an ellipsis if cut
+func truncateWidth(s string, width int) string {
+	if width <= 0 || stringWidth(s) <= width {
+		return s
+	}
+	var b strings.Builder
+	w := 0
+	for _, r := range s {
+		rw := runeWidth(r)
+		if w+rw > width-1 { // leave room for the ellipsis
+			break
+		}
+		b.WriteRune(r)
+		w += rw
+	}
+	b.WriteRune('…')
+	return b.String()
+}
+
+// splits s into rows of at most `width` columns, breaking at spaces where
+// possible. Returns at most maxRows rows, the last one truncated if needed.
+func wrapWidth(s string, width int, maxRows int) []string {
+	if width <= 0 || stringWidth(s) <= width {
+		return []string{s}
+	}
+	var rows []string
+	rest := []rune(s)
+	for len(rest) > 0 && len(rows) < maxRows-1 {
+		w, cut, lastSpace := 0, 0, -1
+		for cut < len(rest) && w+runeWidth(rest[cut]) <= width {
+			if rest[cut] == ' ' {
+				lastSpace = cut
+			}
+			w += runeWidth(rest[cut])
+			cut++
+		}
+		if cut == len(rest) {
+			break
+		}
+		if lastSpace > 0 {
+			cut = lastSpace
+		}
+		rows = append(rows, strings.TrimRight(string(rest[:cut]), " "))
+		rest = []rune(strings.TrimLeft(string(rest[cut:]), " "))
+	}
+	if len(rest) > 0 {
+		rows = append(rows, truncateWidth(string(rest), width))
+	}
+	return rows
+}